@@ -0,0 +1,404 @@
+// Package artwork downloads TVDB artwork to local disk.
+package artwork
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // register GIF for dimension checks
+	_ "image/jpeg" // register JPEG for dimension checks
+	_ "image/png"  // register PNG for dimension checks
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// DefaultLayout is the directory layout used when none is configured
+const DefaultLayout = "{type}/{id}{ext}"
+
+const (
+	defaultConcurrency = 4
+	etagSuffix         = ".etag"
+	thumbnailSuffix    = "_t"
+)
+
+var (
+	// ErrInvalidContentType is returned when the server does not respond with an image
+	ErrInvalidContentType = errors.New("artwork: response is not an image")
+	// ErrDimensionMismatch is returned when the image size differs from the artwork metadata
+	ErrDimensionMismatch = errors.New("artwork: image dimensions do not match")
+)
+
+// Item is an artwork to download together with the IDs of the record it
+// belongs to, which can be used in the layout.
+type Item struct {
+	Artwork  models.Artwork
+	SeriesID int
+	SeasonID int
+	MovieID  int
+	PeopleID int
+}
+
+// ItemFromExtended creates an Item from an extended artwork record.
+func ItemFromExtended(a models.ArtworkExtended) Item {
+	return Item{
+		Artwork:  a.Artwork,
+		SeriesID: a.SeriesID,
+		SeasonID: a.SeasonID,
+		MovieID:  a.MovieID,
+		PeopleID: a.PeopleID,
+	}
+}
+
+// Result reports the outcome of downloading one Item.
+type Result struct {
+	Item Item
+	// Path is the file the image was written to.
+	Path string
+	// Skipped is true when the file on disk was already up to date.
+	Skipped bool
+	Err     error
+}
+
+// Option configures a Downloader created by NewDownloader.
+type Option func(*Downloader)
+
+// WithHTTPClient sets the client used to download images. Use
+// client.Client.HTTPClient to share the TVDB client's transport.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(d *Downloader) {
+		d.httpClient = httpClient
+	}
+}
+
+// WithLayout sets the path of each file relative to the download directory.
+// The placeholders {seriesId}, {seasonId}, {movieId}, {peopleId}, {id},
+// {type}, {typeId}, {language} and {ext} are replaced with the values of the
+// item, e.g. "{seriesId}/{type}{ext}" gives "81189/poster.jpg".
+func WithLayout(layout string) Option {
+	return func(d *Downloader) {
+		d.layout = layout
+	}
+}
+
+// WithConcurrency sets how many files are downloaded at the same time.
+func WithConcurrency(n int) Option {
+	return func(d *Downloader) {
+		d.concurrency = n
+	}
+}
+
+// WithThumbnails also downloads the thumbnail of each artwork, next to the
+// image with "_t" appended to its name.
+func WithThumbnails() Option {
+	return func(d *Downloader) {
+		d.thumbnails = true
+	}
+}
+
+// Downloader downloads artwork into a directory. It is safe for concurrent use.
+type Downloader struct {
+	dir         string
+	httpClient  *http.Client
+	layout      string
+	concurrency int
+	thumbnails  bool
+}
+
+// NewDownloader creates a Downloader that writes files under dir.
+func NewDownloader(dir string, opts ...Option) *Downloader {
+	d := &Downloader{
+		dir:         dir,
+		httpClient:  http.DefaultClient,
+		layout:      DefaultLayout,
+		concurrency: defaultConcurrency,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if d.concurrency < 1 {
+		d.concurrency = 1
+	}
+	return d
+}
+
+// Download downloads the items concurrently and returns one Result per item,
+// in the same order. The returned error joins the errors of all failed items.
+func (d *Downloader) Download(ctx context.Context, items ...Item) ([]Result, error) {
+	results := make([]Result, len(items))
+	sem := make(chan struct{}, d.concurrency)
+
+	var wg sync.WaitGroup
+	for i, item := range items {
+		wg.Add(1)
+		go func(i int, item Item) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				results[i] = Result{Item: item, Err: ctx.Err()}
+				return
+			}
+			results[i] = d.download(ctx, item)
+		}(i, item)
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, r.Err)
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// Path returns the file an item is written to.
+func (d *Downloader) Path(item Item) string {
+	return filepath.Join(d.dir, filepath.FromSlash(d.expand(item)))
+}
+
+func (d *Downloader) download(ctx context.Context, item Item) Result {
+	result := Result{Item: item, Path: d.Path(item)}
+
+	skipped, err := d.fetch(ctx, item.Artwork.URL, result.Path, item.Artwork.Width, item.Artwork.Height)
+	if err != nil {
+		result.Err = fmt.Errorf("artwork %d: %w", item.Artwork.ID, err)
+		return result
+	}
+	result.Skipped = skipped
+
+	if d.thumbnails && item.Artwork.Thumbnail != "" {
+		ext := filepath.Ext(result.Path)
+		thumbPath := strings.TrimSuffix(result.Path, ext) + thumbnailSuffix + ext
+		// Thumbnail sizes are not part of the artwork metadata
+		if _, err := d.fetch(ctx, item.Artwork.Thumbnail, thumbPath, 0, 0); err != nil {
+			result.Err = fmt.Errorf("artwork %d thumbnail: %w", item.Artwork.ID, err)
+		}
+	}
+
+	return result
+}
+
+// fetch downloads rawURL to dest unless dest is already up to date, which it
+// reports as skipped. Width and height are checked when non-zero.
+func (d *Downloader) fetch(ctx context.Context, rawURL, dest string, width, height int) (skipped bool, err error) {
+	if info, err := os.Stat(dest); err == nil && d.unchanged(ctx, rawURL, dest, info.Size()) {
+		return true, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return false, fmt.Errorf("error creating request: %w", err)
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("error downloading %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("error downloading %s: unexpected status code: %d", rawURL, resp.StatusCode)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if !strings.HasPrefix(mediaType, "image/") {
+		return false, fmt.Errorf("%w: %s has content type %q", ErrInvalidContentType, rawURL, mediaType)
+	}
+
+	if err := writeFile(dest, resp.Body, width, height, resp.Header.Get("ETag")); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// unchanged reports whether the existing file at dest matches rawURL, using a
+// HEAD request so unchanged images are not downloaded again. The ETag saved
+// with the file is compared when there is one, otherwise the size. Any
+// failure is treated as changed, leaving the download to report it.
+func (d *Downloader) unchanged(ctx context.Context, rawURL, dest string, size int64) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, rawURL, nil)
+	if err != nil {
+		return false
+	}
+
+	etag := ""
+	if b, err := os.ReadFile(dest + etagSuffix); err == nil {
+		etag = strings.TrimSpace(string(b))
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified:
+		return etag != ""
+	case resp.StatusCode != http.StatusOK:
+		return false
+	case etag != "":
+		// Servers that ignore If-None-Match still return the current ETag
+		return resp.Header.Get("ETag") == etag
+	default:
+		return resp.Header.Get("ETag") == "" && resp.ContentLength >= 0 && resp.ContentLength == size
+	}
+}
+
+// writeFile writes r to dest atomically after checking the image dimensions.
+// The old ETag is removed before the image is replaced and the new one is
+// saved after, so an interrupted write at worst leaves an image without an
+// ETag, which is downloaded again by the next run.
+func writeFile(dest string, r io.Reader, width, height int, etag string) (err error) {
+	dir := filepath.Dir(dest)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("error creating directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".artwork-*")
+	if err != nil {
+		return fmt.Errorf("error creating temporary file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing %s: %w", dest, err)
+	}
+
+	if width > 0 && height > 0 {
+		if err := checkDimensions(tmp, width, height); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing %s: %w", dest, err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("error writing %s: %w", dest, err)
+	}
+	if err := removeETag(dest); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), dest); err != nil {
+		return fmt.Errorf("error writing %s: %w", dest, err)
+	}
+	if etag == "" {
+		return nil
+	}
+	return writeETag(dest, etag)
+}
+
+// removeETag removes the ETag saved for dest, if any.
+func removeETag(dest string) error {
+	if err := os.Remove(dest + etagSuffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error removing etag: %w", err)
+	}
+	return nil
+}
+
+// writeETag atomically saves etag for dest.
+func writeETag(dest, etag string) (err error) {
+	path := dest + etagSuffix
+	tmp, err := os.CreateTemp(filepath.Dir(dest), ".etag-*")
+	if err != nil {
+		return fmt.Errorf("error writing etag: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err := tmp.WriteString(etag); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing etag: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing etag: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("error writing etag: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("error writing etag: %w", err)
+	}
+	return nil
+}
+
+// checkDimensions compares the size of the image in f with width and height.
+// Formats without a registered decoder, such as WebP, are not checked.
+func checkDimensions(f *os.File, width, height int) error {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("error reading image: %w", err)
+	}
+
+	config, _, err := image.DecodeConfig(f)
+	if errors.Is(err, image.ErrFormat) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error decoding image: %w", err)
+	}
+
+	if config.Width != width || config.Height != height {
+		return fmt.Errorf("%w: got %dx%d, want %dx%d", ErrDimensionMismatch, config.Width, config.Height, width, height)
+	}
+	return nil
+}
+
+// expand replaces the layout placeholders with the values of item.
+func (d *Downloader) expand(item Item) string {
+	a := item.Artwork
+
+	kind := string(a.Kind())
+	if kind == "" {
+		kind = "type-" + strconv.Itoa(a.Type)
+	}
+
+	return strings.NewReplacer(
+		"{seriesId}", strconv.Itoa(item.SeriesID),
+		"{seasonId}", strconv.Itoa(item.SeasonID),
+		"{movieId}", strconv.Itoa(item.MovieID),
+		"{peopleId}", strconv.Itoa(item.PeopleID),
+		"{id}", strconv.Itoa(a.ID),
+		"{type}", kind,
+		"{typeId}", strconv.Itoa(a.Type),
+		"{language}", a.Language,
+		"{ext}", extension(a.URL),
+	).Replace(d.layout)
+}
+
+// extension returns the file extension of the image URL, defaulting to ".jpg".
+func extension(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ".jpg"
+	}
+	if ext := path.Ext(u.Path); ext != "" {
+		return strings.ToLower(ext)
+	}
+	return ".jpg"
+}
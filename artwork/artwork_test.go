@@ -0,0 +1,190 @@
+package artwork
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/LaughinKuma/tvdb-go-api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPNG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestDownload(t *testing.T) {
+	poster := testPNG(t, 4, 6)
+	thumb := testPNG(t, 2, 3)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		if r.URL.Path == "/poster_t.png" {
+			w.Write(thumb)
+			return
+		}
+		w.Write(poster)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	d := NewDownloader(dir, WithLayout("{seriesId}/{type}{ext}"), WithThumbnails())
+
+	item := Item{
+		SeriesID: 81189,
+		Artwork: models.Artwork{
+			ID:        5,
+			Type:      models.ArtworkTypeSeriesPoster,
+			URL:       server.URL + "/poster.png",
+			Thumbnail: server.URL + "/poster_t.png",
+			Width:     4,
+			Height:    6,
+		},
+	}
+
+	results, err := d.Download(context.Background(), item)
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, filepath.Join(dir, "81189", "poster.png"), results[0].Path)
+	assert.False(t, results[0].Skipped)
+
+	got, err := os.ReadFile(filepath.Join(dir, "81189", "poster.png"))
+	require.NoError(t, err)
+	assert.Equal(t, poster, got)
+
+	got, err = os.ReadFile(filepath.Join(dir, "81189", "poster_t.png"))
+	require.NoError(t, err)
+	assert.Equal(t, thumb, got)
+}
+
+func TestDownloadSkipsUnchangedFiles(t *testing.T) {
+	poster := testPNG(t, 4, 6)
+
+	tests := []struct {
+		name        string
+		etag        string
+		changedETag string
+		changedBody []byte
+	}{
+		{"matching etag", `"v1"`, `"v2"`, poster},
+		{"matching size", "", "", testPNG(t, 8, 8)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var downloads int32
+			etag, body := tt.etag, poster
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if etag != "" {
+					if r.Header.Get("If-None-Match") == etag {
+						w.WriteHeader(http.StatusNotModified)
+						return
+					}
+					w.Header().Set("ETag", etag)
+				}
+				w.Header().Set("Content-Type", "image/png")
+				w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+				if r.Method == http.MethodGet {
+					atomic.AddInt32(&downloads, 1)
+					w.Write(body)
+				}
+			}))
+			defer server.Close()
+
+			dir := t.TempDir()
+			d := NewDownloader(dir)
+			item := Item{Artwork: models.Artwork{ID: 5, Type: models.ArtworkTypeSeriesPoster, URL: server.URL + "/poster.png"}}
+
+			results, err := d.Download(context.Background(), item)
+			require.NoError(t, err)
+			assert.False(t, results[0].Skipped)
+
+			results, err = d.Download(context.Background(), item)
+			require.NoError(t, err)
+			assert.True(t, results[0].Skipped)
+			assert.Equal(t, int32(1), atomic.LoadInt32(&downloads))
+
+			// A changed image is downloaded again
+			etag, body = tt.changedETag, tt.changedBody
+			results, err = d.Download(context.Background(), item)
+			require.NoError(t, err)
+			assert.False(t, results[0].Skipped)
+			assert.Equal(t, int32(2), atomic.LoadInt32(&downloads))
+
+			got, err := os.ReadFile(results[0].Path)
+			require.NoError(t, err)
+			assert.Equal(t, body, got)
+			if etag != "" {
+				saved, err := os.ReadFile(results[0].Path + etagSuffix)
+				require.NoError(t, err)
+				assert.Equal(t, etag, string(saved))
+			}
+
+			// Only the image and its ETag are left in the directory
+			entries, _ := os.ReadDir(filepath.Dir(results[0].Path))
+			for _, e := range entries {
+				assert.False(t, strings.HasPrefix(e.Name(), "."), e.Name())
+			}
+		})
+	}
+}
+
+func TestDownloadValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        []byte
+		wantErr     error
+	}{
+		{"not an image", "text/html", []byte("<html></html>"), ErrInvalidContentType},
+		{"wrong dimensions", "image/png", testPNG(t, 1, 1), ErrDimensionMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				w.Write(tt.body)
+			}))
+			defer server.Close()
+
+			dir := t.TempDir()
+			d := NewDownloader(dir)
+			item := Item{Artwork: models.Artwork{ID: 5, Type: models.ArtworkTypeSeriesPoster, URL: server.URL + "/poster.png", Width: 4, Height: 6}}
+
+			results, err := d.Download(context.Background(), item)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.ErrorIs(t, results[0].Err, tt.wantErr)
+			assert.NoFileExists(t, results[0].Path)
+
+			// No temporary files are left behind
+			entries, _ := os.ReadDir(filepath.Join(dir, "poster"))
+			assert.Empty(t, entries)
+		})
+	}
+}
+
+func TestPath(t *testing.T) {
+	d := NewDownloader("/data", WithLayout("{movieId}/{type}-{language}-{id}{ext}"))
+
+	path := d.Path(Item{
+		MovieID: 42,
+		Artwork: models.Artwork{ID: 7, Type: 99, Language: "eng", URL: "https://example.com/banners/movies/42/abc.JPG?x=1"},
+	})
+
+	assert.Equal(t, filepath.Join("/data", "42", "type-99-eng-7.jpg"), path)
+}
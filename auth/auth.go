@@ -2,32 +2,54 @@
 package auth
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
+	"sync"
 	"time"
 
+	"github.com/LaughinKuma/tvdb-go-api/internal/apierror"
 	"github.com/hashicorp/go-retryablehttp"
 )
 
 const (
 	// BaseURL is the base URL for the TVDB API.
 	DefaultBaseURL = "https://api4.thetvdb.com/v4"
-	
+
 	loginPath = "/login"
-	
+
 	// AuthHeader is the name of the authorization header used in API requests.
 	AuthHeader = "Authorization"
+
+	// DefaultRefreshLeeway is how long before its expiry a token is refreshed.
+	DefaultRefreshLeeway = 1 * time.Hour
+
+	// loginTimeout bounds a login, which is not cancelled by its callers.
+	loginTimeout = 1 * time.Minute
 )
 
 // Auth holds the authentication information for the TVDB API.
+// It is safe for concurrent use.
 type Auth struct {
-	APIKey  string
-	Token   string
-	client  *retryablehttp.Client
-	baseURL string
+	APIKey        string
+	pin           string
+	client        *retryablehttp.Client
+	baseURL       string
+	refreshLeeway time.Duration
+	store         TokenStore
+
+	mu          sync.Mutex
+	token       string
+	expiry      time.Time
+	inflight    *loginCall
+	storeLoaded bool
 }
 
+// loginCall tracks a login in progress so concurrent callers can share it.
+type loginCall struct {
+	done chan struct{}
+	err  error
+}
 
 type loginResponse struct {
 	Status string `json:"status"`
@@ -36,76 +58,309 @@ type loginResponse struct {
 	} `json:"data"`
 }
 
-// NewAuth creates a new Auth instance.
-func NewAuth(apiKey string) *Auth {
-	return NewAuthWithBaseURL(apiKey, DefaultBaseURL)
+// Option configures an Auth created by NewAuth.
+type Option func(*Auth)
+
+// WithBaseURL sets the base URL used for login requests.
+func WithBaseURL(baseURL string) Option {
+	return func(a *Auth) {
+		a.baseURL = baseURL
+	}
 }
 
+// WithHTTPClient sets the retrying HTTP client used for login requests,
+// allowing it to be shared with the API client.
+func WithHTTPClient(client *retryablehttp.Client) Option {
+	return func(a *Auth) {
+		a.client = client
+	}
+}
 
-func NewAuthWithBaseURL(apiKey, baseURL string) *Auth {
-	client := retryablehttp.NewClient()
-	client.RetryMax = 3
-	client.RetryWaitMin = 1 * time.Second
-	client.RetryWaitMax = 5 * time.Second
+// WithPIN sets the subscriber PIN sent alongside the API key on every login.
+// It is required for user-supported API keys.
+func WithPIN(pin string) Option {
+	return func(a *Auth) {
+		a.pin = pin
+	}
+}
+
+// WithRefreshLeeway sets how long before its expiry a token is refreshed.
+func WithRefreshLeeway(leeway time.Duration) Option {
+	return func(a *Auth) {
+		a.refreshLeeway = leeway
+	}
+}
 
-	return &Auth{
-		APIKey:  apiKey,
-		client:  client,
-		baseURL: baseURL,
+// WithTokenStore sets a TokenStore used to reuse tokens across restarts. The
+// stored token is loaded before the first login, and every new token is saved.
+func WithTokenStore(store TokenStore) Option {
+	return func(a *Auth) {
+		a.store = store
 	}
 }
 
+// NewAuth creates a new Auth instance.
+func NewAuth(apiKey string, opts ...Option) *Auth {
+	a := &Auth{
+		APIKey:        apiKey,
+		baseURL:       DefaultBaseURL,
+		refreshLeeway: DefaultRefreshLeeway,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	if a.client == nil {
+		client := retryablehttp.NewClient()
+		client.RetryMax = 3
+		client.RetryWaitMin = 1 * time.Second
+		client.RetryWaitMax = 5 * time.Second
+		a.client = client
+	}
+
+	return a
+}
+
+// NewAuthWithBaseURL creates a new Auth instance that logs in against baseURL.
+func NewAuthWithBaseURL(apiKey, baseURL string) *Auth {
+	return NewAuth(apiKey, WithBaseURL(baseURL))
+}
+
 // Login authenticates with the TVDB API and obtains a token.
 func (a *Auth) Login() error {
-	url := a.baseURL + loginPath
+	return a.LoginContext(context.Background())
+}
+
+// LoginContext is like Login but uses the provided context for the request.
+// Concurrent calls share a single login request.
+func (a *Auth) LoginContext(ctx context.Context) error {
+	return a.login(ctx, true)
+}
+
+// login obtains a new token. Unless force is set, it returns early when the
+// current token is still valid. If a login is already in progress, it waits
+// for that one instead of starting another. The login itself is not tied to
+// ctx, so a caller giving up does not fail the others waiting for it.
+func (a *Auth) login(ctx context.Context, force bool) error {
+	a.mu.Lock()
+	if !force && a.token == "" {
+		a.loadStoredLocked()
+	}
+	if !force && a.validLocked() {
+		a.mu.Unlock()
+		return nil
+	}
+	call := a.inflight
+	if call == nil {
+		if err := ctx.Err(); err != nil {
+			a.mu.Unlock()
+			return err
+		}
+		call = &loginCall{done: make(chan struct{})}
+		a.inflight = call
+		go a.runLogin(context.WithoutCancel(ctx), call)
+	}
+	a.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// runLogin performs the login shared by all callers waiting on call.
+func (a *Auth) runLogin(ctx context.Context, call *loginCall) {
+	ctx, cancel := context.WithTimeout(ctx, loginTimeout)
+	defer cancel()
+
+	token, err := a.requestToken(ctx)
+
+	a.mu.Lock()
+	if err == nil {
+		a.setTokenLocked(token)
+	}
+	a.inflight = nil
+	key := a.storeKeyLocked()
+	a.mu.Unlock()
+
+	if err == nil && a.store != nil {
+		// Failing to persist the token does not make the login fail; the
+		// next process will simply log in again.
+		_ = a.store.Save(key, token)
+	}
+
+	call.err = err
+	close(call.done)
+}
+
+// loadStoredLocked loads the token from the store the first time it is needed.
+// Load errors are ignored so that a broken store falls back to logging in.
+func (a *Auth) loadStoredLocked() {
+	if a.store == nil || a.storeLoaded {
+		return
+	}
+	a.storeLoaded = true
+
+	if token, err := a.store.Load(a.storeKeyLocked()); err == nil && token != "" {
+		a.setTokenLocked(token)
+	}
+}
+
+// storeKeyLocked returns the key tokens are stored under. Tokens are only
+// valid for the server and account they were issued for, so the base URL and
+// PIN are part of the key as well as the API key.
+func (a *Auth) storeKeyLocked() string {
+	return a.APIKey + "\x00" + a.baseURL + "\x00" + a.pin
+}
+
+// requestToken sends the login request and returns the received token.
+func (a *Auth) requestToken(ctx context.Context) (string, error) {
+	url := a.BaseURL() + loginPath
 
 	body := map[string]string{"apikey": a.APIKey}
+	if a.pin != "" {
+		body["pin"] = a.pin
+	}
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
-		return fmt.Errorf("error marshaling login request: %w", err)
+		return "", fmt.Errorf("error marshaling login request: %w", err)
 	}
 
-	req, err := retryablehttp.NewRequest("POST", url, jsonBody)
+	req, err := retryablehttp.NewRequestWithContext(ctx, "POST", url, jsonBody)
 	if err != nil {
-		return fmt.Errorf("error creating login request: %w", err)
+		return "", fmt.Errorf("error creating login request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := a.client.Do(req)
 	if err != nil {
-		return fmt.Errorf("error sending login request: %w", err)
+		// Some error handlers return the last response alongside the error
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return "", fmt.Errorf("error sending login request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("login failed with status: %s", resp.Status)
+	if err := apierror.CheckResponse(resp, "POST", loginPath); err != nil {
+		return "", fmt.Errorf("login failed: %w", err)
 	}
 
 	var loginResp loginResponse
 	if err := json.NewDecoder(resp.Body).Decode(&loginResp); err != nil {
-		return fmt.Errorf("error decoding login response: %w", err)
+		return "", fmt.Errorf("error decoding login response: %w", err)
 	}
 
 	if loginResp.Data.Token == "" {
-		return fmt.Errorf("no token received in login response")
+		return "", fmt.Errorf("no token received in login response")
+	}
+
+	return loginResp.Data.Token, nil
+}
+
+// TokenContext returns a valid token, logging in first if there is no token
+// yet or the current one is about to expire. If a proactive refresh fails
+// while the current token has not yet expired, the current token is returned.
+func (a *Auth) TokenContext(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	current, expiry := a.token, a.expiry
+	a.mu.Unlock()
+
+	if err := a.login(ctx, false); err != nil {
+		if current != "" && (expiry.IsZero() || time.Now().Before(expiry)) {
+			return current, nil
+		}
+		return "", err
+	}
+	return a.Token(), nil
+}
+
+// Token returns the current token, which may be empty.
+func (a *Auth) Token() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.token
+}
+
+// SetToken replaces the current token, e.g. with one obtained elsewhere.
+func (a *Auth) SetToken(token string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.setTokenLocked(token)
+}
+
+// TokenExpiry returns the expiry time of the current token, or the zero time
+// if there is no token or its expiry is unknown.
+func (a *Auth) TokenExpiry() time.Time {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.expiry
+}
+
+// Invalidate discards token if it is still the current token, so that the
+// next call to TokenContext logs in again. Passing the token that was
+// rejected avoids discarding a token another goroutine has just obtained.
+func (a *Auth) Invalidate(token string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.token == token {
+		a.setTokenLocked("")
+	}
+}
+
+func (a *Auth) setTokenLocked(token string) {
+	a.token = token
+	a.expiry = tokenExpiry(token)
+	if token != "" {
+		// The stored token is at best this one, and must not be reloaded
+		// once it has been invalidated
+		a.storeLoaded = true
 	}
+}
+
+// validLocked reports whether the current token exists and is not due for refresh.
+func (a *Auth) validLocked() bool {
+	if a.token == "" {
+		return false
+	}
+	return a.expiry.IsZero() || time.Now().Add(a.refreshLeeway).Before(a.expiry)
+}
 
-	a.Token = loginResp.Data.Token
-	return nil
+// SetBaseURL changes the base URL used for login requests.
+func (a *Auth) SetBaseURL(url string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.baseURL = url
+}
+
+// BaseURL returns the base URL used for login requests.
+func (a *Auth) BaseURL() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.baseURL
 }
 
 // GetAuthHeader returns the authorization header for API requests.
 func (a *Auth) GetAuthHeader() string {
-	return fmt.Sprintf("Bearer %s", a.Token)
+	return fmt.Sprintf("Bearer %s", a.Token())
 }
 
 // IsAuthenticated checks if the current token is valid.
 func (a *Auth) IsAuthenticated() bool {
-	return a.Token != ""
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.token != "" && (a.expiry.IsZero() || time.Now().Before(a.expiry))
 }
 
 // RefreshToken attempts to refresh the authentication token.
 func (a *Auth) RefreshToken() error {
+	return a.RefreshTokenContext(context.Background())
+}
+
+// RefreshTokenContext is like RefreshToken but uses the provided context.
+func (a *Auth) RefreshTokenContext(ctx context.Context) error {
 	// For TVDB API v4, we simply re-login to refresh the token
-	return a.Login()
-}
\ No newline at end of file
+	return a.LoginContext(ctx)
+}
@@ -1,11 +1,20 @@
 package auth
 
 import (
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,6 +26,16 @@ func TestNewAuth(t *testing.T) {
 	assert.NotNil(t, auth.client)
 }
 
+func TestNewAuthWithOptions(t *testing.T) {
+	client := retryablehttp.NewClient()
+	auth := NewAuth("test-api-key", WithBaseURL("http://example.com"), WithHTTPClient(client))
+	assert.Equal(t, "http://example.com", auth.BaseURL())
+	assert.Same(t, client, auth.client)
+
+	auth.SetBaseURL("http://other.example.com")
+	assert.Equal(t, "http://other.example.com", auth.BaseURL())
+}
+
 func TestLogin(t *testing.T) {
 	// Create a test server to mock the TVDB API
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -42,12 +61,51 @@ func TestLogin(t *testing.T) {
 
 	err := auth.Login()
 	assert.NoError(t, err)
-	assert.Equal(t, "test-token", auth.Token)
+	assert.Equal(t, "test-token", auth.Token())
+}
+
+func TestLoginContextCanceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	}))
+	defer ts.Close()
+
+	auth := NewAuthWithBaseURL("test-api-key", ts.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := auth.LoginContext(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, auth.IsAuthenticated())
+}
+
+func TestLoginWithPIN(t *testing.T) {
+	var bodies []map[string]string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requestBody map[string]string
+		json.NewDecoder(r.Body).Decode(&requestBody)
+		bodies = append(bodies, requestBody)
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data":   map[string]string{"token": "test-token"},
+		})
+	}))
+	defer ts.Close()
+
+	auth := NewAuth("test-api-key", WithBaseURL(ts.URL), WithPIN("1234"))
+
+	assert.NoError(t, auth.Login())
+	assert.NoError(t, auth.RefreshToken())
+
+	expected := map[string]string{"apikey": "test-api-key", "pin": "1234"}
+	assert.Equal(t, []map[string]string{expected, expected}, bodies)
 }
 
 func TestGetAuthHeader(t *testing.T) {
 	auth := NewAuth("test-api-key")
-	auth.Token = "test-token"
+	auth.SetToken("test-token")
 
 	header := auth.GetAuthHeader()
 	assert.Equal(t, "Bearer test-token", header)
@@ -57,7 +115,7 @@ func TestIsAuthenticated(t *testing.T) {
 	auth := NewAuth("test-api-key")
 	assert.False(t, auth.IsAuthenticated())
 
-	auth.Token = "test-token"
+	auth.SetToken("test-token")
 	assert.True(t, auth.IsAuthenticated())
 }
 
@@ -79,9 +137,270 @@ func TestRefreshToken(t *testing.T) {
 
 	// Create an Auth instance with the test server URL
 	auth := NewAuthWithBaseURL("test-api-key", ts.URL)
-	auth.Token = "old-test-token"
+	auth.SetToken("old-test-token")
 
 	err := auth.RefreshToken()
 	assert.NoError(t, err)
-	assert.Equal(t, "new-test-token", auth.Token)
-}
\ No newline at end of file
+	assert.Equal(t, "new-test-token", auth.Token())
+}
+
+// testJWT returns an unsigned JWT whose exp claim is set to exp.
+func testJWT(exp time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, exp.Unix())))
+	return header + "." + payload + ".signature"
+}
+
+// newLoginServer returns a test server that answers logins with token and
+// counts them in logins.
+func newLoginServer(token string, logins *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(logins, 1)
+		time.Sleep(10 * time.Millisecond)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data":   map[string]string{"token": token},
+		})
+	}))
+}
+
+func TestTokenExpiry(t *testing.T) {
+	exp := time.Now().Add(30 * 24 * time.Hour).Truncate(time.Second)
+
+	auth := NewAuth("test-api-key")
+	assert.True(t, auth.TokenExpiry().IsZero())
+
+	auth.SetToken(testJWT(exp))
+	assert.True(t, exp.Equal(auth.TokenExpiry()))
+	assert.True(t, auth.IsAuthenticated())
+
+	auth.SetToken(testJWT(time.Now().Add(-time.Minute)))
+	assert.False(t, auth.IsAuthenticated())
+
+	auth.SetToken("not-a-jwt")
+	assert.True(t, auth.TokenExpiry().IsZero())
+	assert.True(t, auth.IsAuthenticated())
+}
+
+func TestTokenContextRefreshesAheadOfExpiry(t *testing.T) {
+	newToken := testJWT(time.Now().Add(30 * 24 * time.Hour))
+	var logins int32
+	ts := newLoginServer(newToken, &logins)
+	defer ts.Close()
+
+	auth := NewAuth("test-api-key", WithBaseURL(ts.URL), WithRefreshLeeway(time.Hour))
+
+	valid := testJWT(time.Now().Add(2 * time.Hour))
+	auth.SetToken(valid)
+	token, err := auth.TokenContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, valid, token)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&logins))
+
+	auth.SetToken(testJWT(time.Now().Add(30 * time.Minute)))
+	token, err = auth.TokenContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, newToken, token)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&logins))
+}
+
+func TestTokenContextKeepsTokenWhenRefreshFails(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	auth := NewAuth("test-api-key", WithBaseURL(ts.URL))
+	expiring := testJWT(time.Now().Add(10 * time.Minute))
+	auth.SetToken(expiring)
+
+	token, err := auth.TokenContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, expiring, token)
+}
+
+func TestConcurrentLoginsAreCoalesced(t *testing.T) {
+	var logins int32
+	ts := newLoginServer("test-token", &logins)
+	defer ts.Close()
+
+	auth := NewAuth("test-api-key", WithBaseURL(ts.URL))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := auth.TokenContext(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "test-token", token)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&logins))
+}
+
+func TestCoalescedLoginOutlivesLeaderDeadline(t *testing.T) {
+	var logins int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&logins, 1)
+		time.Sleep(100 * time.Millisecond)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success",
+			"data":   map[string]string{"token": "test-token"},
+		})
+	}))
+	defer ts.Close()
+
+	auth := NewAuth("test-api-key", WithBaseURL(ts.URL))
+
+	// The leader starts the login and gives up before it completes
+	leaderErr := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := auth.TokenContext(ctx)
+		leaderErr <- err
+	}()
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&logins) == 1 }, time.Second, time.Millisecond)
+
+	token, err := auth.TokenContext(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test-token", token)
+	assert.ErrorIs(t, <-leaderErr, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&logins))
+}
+
+func TestInvalidate(t *testing.T) {
+	auth := NewAuth("test-api-key")
+	auth.SetToken("current-token")
+
+	auth.Invalidate("stale-token")
+	assert.Equal(t, "current-token", auth.Token())
+
+	auth.Invalidate("current-token")
+	assert.Equal(t, "", auth.Token())
+	assert.False(t, auth.IsAuthenticated())
+}
+
+func TestMemoryTokenStore(t *testing.T) {
+	store := NewMemoryTokenStore()
+
+	token, err := store.Load("key-a")
+	assert.NoError(t, err)
+	assert.Equal(t, "", token)
+
+	assert.NoError(t, store.Save("key-a", "token-a"))
+	assert.NoError(t, store.Save("key-b", "token-b"))
+
+	token, _ = store.Load("key-a")
+	assert.Equal(t, "token-a", token)
+	token, _ = store.Load("key-b")
+	assert.Equal(t, "token-b", token)
+}
+
+func TestFileTokenStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "tokens")
+	store := NewFileTokenStore(dir)
+
+	token, err := store.Load("key-a")
+	assert.NoError(t, err)
+	assert.Equal(t, "", token)
+
+	assert.NoError(t, store.Save("key-a", "token-a"))
+	assert.NoError(t, store.Save("key-b", "token-b"))
+	assert.NoError(t, store.Save("key-a", "token-a2"))
+
+	token, _ = store.Load("key-a")
+	assert.Equal(t, "token-a2", token)
+	token, _ = store.Load("key-b")
+	assert.Equal(t, "token-b", token)
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	for _, entry := range entries {
+		assert.NotContains(t, entry.Name(), "key-")
+		info, err := entry.Info()
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+}
+
+func TestTokenStoreReuse(t *testing.T) {
+	var logins int32
+	ts := newLoginServer("fresh-token", &logins)
+	defer ts.Close()
+
+	store := NewMemoryTokenStore()
+	stored := testJWT(time.Now().Add(30 * 24 * time.Hour))
+
+	auth := NewAuth("test-api-key", WithBaseURL(ts.URL), WithTokenStore(store))
+	store.Save(auth.storeKeyLocked(), stored)
+	token, err := auth.TokenContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, stored, token)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&logins))
+
+	// A rejected token triggers a login whose result is saved
+	auth.Invalidate(stored)
+	token, err = auth.TokenContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "fresh-token", token)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&logins))
+
+	saved, _ := store.Load(auth.storeKeyLocked())
+	assert.Equal(t, "fresh-token", saved)
+}
+
+func TestTokenStoreKeyedByAccount(t *testing.T) {
+	var logins int32
+	ts := newLoginServer("fresh-token", &logins)
+	defer ts.Close()
+
+	store := NewFileTokenStore(t.TempDir())
+	stored := testJWT(time.Now().Add(30 * 24 * time.Hour))
+	owner := NewAuth("test-api-key", WithBaseURL(ts.URL), WithPIN("1234"), WithTokenStore(store))
+	assert.NoError(t, store.Save(owner.storeKeyLocked(), stored))
+
+	tests := []struct {
+		name string
+		auth *Auth
+	}{
+		{"other pin", NewAuth("test-api-key", WithBaseURL(ts.URL), WithPIN("5678"), WithTokenStore(store))},
+		{"no pin", NewAuth("test-api-key", WithBaseURL(ts.URL), WithTokenStore(store))},
+		{"other base url", NewAuth("test-api-key", WithBaseURL(ts.URL+"/v4"), WithPIN("1234"), WithTokenStore(store))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.auth.mu.Lock()
+			tt.auth.loadStoredLocked()
+			token := tt.auth.token
+			tt.auth.mu.Unlock()
+			assert.Equal(t, "", token)
+		})
+	}
+
+	token, err := owner.TokenContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, stored, token)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&logins))
+}
+
+func TestTokenStoreExpiredToken(t *testing.T) {
+	var logins int32
+	ts := newLoginServer("fresh-token", &logins)
+	defer ts.Close()
+
+	store := NewMemoryTokenStore()
+
+	auth := NewAuth("test-api-key", WithBaseURL(ts.URL), WithTokenStore(store))
+	store.Save(auth.storeKeyLocked(), testJWT(time.Now().Add(-time.Hour)))
+	token, err := auth.TokenContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "fresh-token", token)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&logins))
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// TokenStore persists tokens so they can be reused across process restarts.
+// Auth identifies tokens by a key combining the API key, base URL and PIN, so
+// one store can hold tokens for several accounts and servers.
+// Implementations must be safe for concurrent use.
+type TokenStore interface {
+	// Load returns the stored token for key, or "" if there is none.
+	Load(key string) (string, error)
+	// Save stores token for key, replacing any previous token.
+	Save(key, token string) error
+}
+
+// MemoryTokenStore is a TokenStore that keeps tokens in memory. It is useful
+// for sharing a token between several Auth instances in one process.
+type MemoryTokenStore struct {
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+// NewMemoryTokenStore creates an empty MemoryTokenStore.
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{tokens: make(map[string]string)}
+}
+
+// Load implements TokenStore.
+func (s *MemoryTokenStore) Load(key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokens[key], nil
+}
+
+// Save implements TokenStore.
+func (s *MemoryTokenStore) Save(key, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[key] = token
+	return nil
+}
+
+// FileTokenStore is a TokenStore that keeps one file per key in a
+// directory. Files are named after a hash of the key and are only readable
+// by the current user.
+type FileTokenStore struct {
+	dir string
+}
+
+// NewFileTokenStore creates a FileTokenStore that stores tokens in dir. The
+// directory is created on first save if it does not exist.
+func NewFileTokenStore(dir string) *FileTokenStore {
+	return &FileTokenStore{dir: dir}
+}
+
+// Load implements TokenStore.
+func (s *FileTokenStore) Load(key string) (string, error) {
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("error reading token file: %w", err)
+	}
+	return string(data), nil
+}
+
+// Save implements TokenStore. The file is replaced atomically.
+func (s *FileTokenStore) Save(key, token string) error {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return fmt.Errorf("error creating token directory: %w", err)
+	}
+
+	// CreateTemp creates the file with 0600 permissions.
+	tmp, err := os.CreateTemp(s.dir, ".token-*")
+	if err != nil {
+		return fmt.Errorf("error creating token file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(token); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing token file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing token file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path(key)); err != nil {
+		return fmt.Errorf("error saving token file: %w", err)
+	}
+	return nil
+}
+
+// path returns the token file for key. The key is hashed so that the API key
+// and PIN it contains do not end up in file names.
+func (s *FileTokenStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, "tvdb-"+hex.EncodeToString(sum[:8])+".token")
+}
@@ -3,11 +3,11 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"time"
 
 	"github.com/LaughinKuma/tvdb-go-api/auth"
 	"github.com/hashicorp/go-retryablehttp"
@@ -16,66 +16,112 @@ import (
 // Client represents the TVDB API client
 
 type ClientInterface interface {
-    Get(path string, result interface{}) error
-    DoRequest(method, path string, body io.Reader) (*http.Response, error)
-    Post(path string, body interface{}, result interface{}) error
-    SetBaseURL(url string)
+	Get(path string, result interface{}) error
+	GetContext(ctx context.Context, path string, result interface{}) error
+	DoRequest(method, path string, body io.Reader) (*http.Response, error)
+	DoRequestContext(ctx context.Context, method, path string, body io.Reader) (*http.Response, error)
+	Post(path string, body interface{}, result interface{}) error
+	PostContext(ctx context.Context, path string, body interface{}, result interface{}) error
+	SetBaseURL(url string)
 }
 
 type Client struct {
 	Auth       *auth.Auth
 	httpClient *retryablehttp.Client
 	baseURL    string
+	languages  []string
 }
 
 // NewClient creates a new TVDB API client
-func NewClient(apiKey string) (*Client, error) {
-	authClient := auth.NewAuth(apiKey)
-	httpClient := retryablehttp.NewClient()
-	httpClient.RetryMax = 3
-	httpClient.RetryWaitMin = 1 * time.Second
-	httpClient.RetryWaitMax = 5 * time.Second
+func NewClient(apiKey string, opts ...Option) (*Client, error) {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	httpClient, err := newHTTPClient(o)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client options: %w", err)
+	}
+
+	authClient := auth.NewAuth(apiKey,
+		auth.WithBaseURL(o.baseURL),
+		auth.WithHTTPClient(httpClient),
+		auth.WithPIN(o.pin),
+		auth.WithTokenStore(o.tokenStore),
+	)
 
 	client := &Client{
 		Auth:       authClient,
 		httpClient: httpClient,
-		baseURL:    auth.DefaultBaseURL,
+		baseURL:    o.baseURL,
+		languages:  o.languages,
 	}
 
-	err := client.Auth.Login()
-	if err != nil {
-		return nil, fmt.Errorf("failed to authenticate: %w", err)
+	if !o.lazyAuth {
+		// Reuses a stored token when one is available
+		if _, err := client.Auth.TokenContext(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to authenticate: %w", err)
+		}
 	}
 
 	return client, nil
 }
 
+// Authenticate logs in with the configured API key, replacing any current
+// token. Callers using WithLazyAuth can use it to fail fast.
+func (c *Client) Authenticate(ctx context.Context) error {
+	if err := c.Auth.LoginContext(ctx); err != nil {
+		return fmt.Errorf("failed to authenticate: %w", err)
+	}
+	return nil
+}
+
 // DoRequest performs an HTTP request and handles authentication
 func (c *Client) DoRequest(method, path string, body io.Reader) (*http.Response, error) {
+	return c.DoRequestContext(context.Background(), method, path, body)
+}
+
+// DoRequestContext is like DoRequest but uses the provided context for the
+// request, including the token refresh and retry after a 401 response.
+func (c *Client) DoRequestContext(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+	// Obtains a token on first use and refreshes it ahead of expiry
+	token, err := c.Auth.TokenContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate: %w", err)
+	}
+
 	url := c.baseURL + path
-	req, err := retryablehttp.NewRequest(method, url, body)
+	req, err := retryablehttp.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set(auth.AuthHeader, c.Auth.GetAuthHeader())
+	req.Header.Set(auth.AuthHeader, "Bearer "+token)
+	if languages := c.Languages(ctx); len(languages) > 0 {
+		req.Header.Set("Accept-Language", acceptLanguage(languages))
+	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	if resp.StatusCode == http.StatusUnauthorized {
-		// Token might be expired, try to refresh
-		err = c.Auth.RefreshToken()
+		resp.Body.Close()
+
+		// Token might be expired, try to refresh. Concurrent requests that
+		// were rejected with the same token share a single login.
+		c.Auth.Invalidate(token)
+		token, err = c.Auth.TokenContext(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("error refreshing token: %w", err)
 		}
 
 		// Retry the request with the new token
-		req.Header.Set(auth.AuthHeader, c.Auth.GetAuthHeader())
-		resp, err = c.httpClient.Do(req)
+		req.Header.Set(auth.AuthHeader, "Bearer "+token)
+		resp, err = c.do(req)
 		if err != nil {
 			return nil, fmt.Errorf("error sending request after token refresh: %w", err)
 		}
@@ -84,16 +130,34 @@ func (c *Client) DoRequest(method, path string, body io.Reader) (*http.Response,
 	return resp, nil
 }
 
+// do sends req. Rate limiting applies to each attempt in the transport.
+func (c *Client) do(req *retryablehttp.Request) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// The response is handed back alongside the error when retries stop
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
 // Get performs a GET request to the specified path
 func (c *Client) Get(path string, result interface{}) error {
-	resp, err := c.DoRequest("GET", path, nil)
+	return c.GetContext(context.Background(), path, result)
+}
+
+// GetContext is like Get but uses the provided context for the request.
+func (c *Client) GetContext(ctx context.Context, path string, result interface{}) error {
+	resp, err := c.DoRequestContext(ctx, "GET", path, nil)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	if err := checkResponse(resp, "GET", path); err != nil {
+		return err
 	}
 
 	err = json.NewDecoder(resp.Body).Decode(result)
@@ -106,19 +170,24 @@ func (c *Client) Get(path string, result interface{}) error {
 
 // Post performs a POST request to the specified path
 func (c *Client) Post(path string, body interface{}, result interface{}) error {
+	return c.PostContext(context.Background(), path, body, result)
+}
+
+// PostContext is like Post but uses the provided context for the request.
+func (c *Client) PostContext(ctx context.Context, path string, body interface{}, result interface{}) error {
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
 		return fmt.Errorf("error marshaling request body: %w", err)
 	}
 
-	resp, err := c.DoRequest("POST", path, bytes.NewReader(jsonBody))
+	resp, err := c.DoRequestContext(ctx, "POST", path, bytes.NewReader(jsonBody))
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	if err := checkResponse(resp, "POST", path); err != nil {
+		return err
 	}
 
 	err = json.NewDecoder(resp.Body).Decode(result)
@@ -129,7 +198,17 @@ func (c *Client) Post(path string, body interface{}, result interface{}) error {
 	return nil
 }
 
-// SetBaseURL allows changing the base URL for API requests
+// HTTPClient returns an *http.Client that sends requests through the
+// configured transport and retry policy, without TVDB authentication or rate
+// limiting. It is meant for downloading artwork from the TVDB image servers.
+func (c *Client) HTTPClient() *http.Client {
+	hc := c.httpClient.StandardClient()
+	hc.Transport = &unlimitedTransport{base: hc.Transport}
+	return hc
+}
+
+// SetBaseURL allows changing the base URL for API requests and login
 func (c *Client) SetBaseURL(url string) {
 	c.baseURL = url
-}
\ No newline at end of file
+	c.Auth.SetBaseURL(url)
+}
@@ -1,21 +1,30 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/LaughinKuma/tvdb-go-api/auth"
-	"github.com/hashicorp/go-retryablehttp"
 	"github.com/stretchr/testify/assert"
 )
 
 // newTestClient creates a new Client with a custom base URL for testing
 func newTestClient(apiKey, baseURL string) (*Client, error) {
 	authClient := auth.NewAuth(apiKey)
-	httpClient := retryablehttp.NewClient()
-	httpClient.RetryMax = 3
+	httpClient, err := newHTTPClient(defaultOptions())
+	if err != nil {
+		return nil, err
+	}
+	httpClient.RetryWaitMin = time.Millisecond
+	httpClient.RetryWaitMax = time.Millisecond
 
 	client := &Client{
 		Auth:       authClient,
@@ -24,7 +33,7 @@ func newTestClient(apiKey, baseURL string) (*Client, error) {
 	}
 
 	// Mock the login process
-	client.Auth.Token = "test-token"
+	client.Auth.SetToken("test-token")
 
 	return client, nil
 }
@@ -46,7 +55,7 @@ func TestNewClient(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, client)
 	assert.Equal(t, ts.URL, client.baseURL)
-	assert.Equal(t, "test-token", client.Auth.Token)
+	assert.Equal(t, "test-token", client.Auth.Token())
 }
 
 func TestDoRequest(t *testing.T) {
@@ -103,8 +112,495 @@ func TestPost(t *testing.T) {
 	assert.Equal(t, "success", responseBody["response"])
 }
 
+func TestGetAPIError(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		sentinel    error
+		wantStatus  string
+		wantMessage string
+	}{
+		{
+			name:        "Not found",
+			status:      http.StatusNotFound,
+			body:        `{"status":"failure","message":"NotFoundException: series does not exist"}`,
+			sentinel:    ErrNotFound,
+			wantStatus:  "failure",
+			wantMessage: "NotFoundException: series does not exist",
+		},
+		{
+			name:     "Rate limited",
+			status:   http.StatusTooManyRequests,
+			body:     `slow down`,
+			sentinel: ErrRateLimited,
+		},
+		{
+			name:     "Server error",
+			status:   http.StatusBadGateway,
+			body:     `<html>bad gateway</html>`,
+			sentinel: ErrServer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "req-123")
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer ts.Close()
+
+			client, _ := newTestClient("test-api-key", ts.URL)
+			client.httpClient.RetryMax = 0
+
+			var result map[string]string
+			err := client.Get("/series/1", &result)
+
+			var apiErr *APIError
+			assert.ErrorAs(t, err, &apiErr)
+			assert.ErrorIs(t, err, tt.sentinel)
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, tt.wantStatus, apiErr.Status)
+			assert.Equal(t, tt.wantMessage, apiErr.Message)
+			assert.Equal(t, "GET", apiErr.Method)
+			assert.Equal(t, "/series/1", apiErr.Path)
+			assert.Equal(t, "req-123", apiErr.RequestID)
+			assert.Equal(t, tt.body, apiErr.Body)
+		})
+	}
+}
+
 func TestSetBaseURL(t *testing.T) {
 	client, _ := newTestClient("test-api-key", "http://old-url.com")
 	client.SetBaseURL("http://new-url.com")
 	assert.Equal(t, "http://new-url.com", client.baseURL)
-}
\ No newline at end of file
+	assert.Equal(t, "http://new-url.com", client.Auth.BaseURL())
+}
+
+func TestNewClientWithOptions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test-agent/1.0", r.Header.Get("User-Agent"))
+		if r.URL.Path == "/login" {
+			var requestBody map[string]string
+			json.NewDecoder(r.Body).Decode(&requestBody)
+			assert.Equal(t, "1234", requestBody["pin"])
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   map[string]string{"token": "test-token"},
+			})
+			return
+		}
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		json.NewEncoder(w).Encode(map[string]string{"data": "test"})
+	}))
+	defer ts.Close()
+
+	var roundTrips int
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		roundTrips++
+		return http.DefaultTransport.RoundTrip(r)
+	})
+
+	client, err := NewClient("test-api-key",
+		WithBaseURL(ts.URL),
+		WithHTTPClient(&http.Client{Transport: transport}),
+		WithUserAgent("test-agent/1.0"),
+		WithPIN("1234"),
+		WithRetryMax(1),
+		WithRetryWait(time.Millisecond, 2*time.Millisecond),
+		WithTimeout(5*time.Second),
+	)
+	assert.NoError(t, err)
+
+	var result map[string]string
+	err = client.Get("/test", &result)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test", result["data"])
+	assert.Equal(t, ts.URL, client.baseURL)
+	assert.Equal(t, ts.URL, client.Auth.BaseURL())
+	assert.Equal(t, 1, client.httpClient.RetryMax)
+	assert.Equal(t, 5*time.Second, client.httpClient.HTTPClient.Timeout)
+	assert.Equal(t, 2, roundTrips)
+}
+
+func TestNewClientProxyRequiresHTTPTransport(t *testing.T) {
+	proxyURL, _ := url.Parse("http://proxy.example.com:3128")
+	transport := roundTripperFunc(http.DefaultTransport.RoundTrip)
+
+	client, err := NewClient("test-api-key", WithTransport(transport), WithProxy(proxyURL))
+
+	assert.Nil(t, client)
+	assert.ErrorContains(t, err, "proxy option requires an *http.Transport")
+}
+
+func TestNewHTTPClientProxy(t *testing.T) {
+	proxyURL, _ := url.Parse("http://proxy.example.com:3128")
+
+	rc, err := newHTTPClient(&options{proxy: proxyURL})
+	assert.NoError(t, err)
+
+	transport, ok := rc.HTTPClient.Transport.(*http.Transport)
+	assert.True(t, ok)
+
+	req, _ := http.NewRequest("GET", "https://api4.thetvdb.com/v4/series/1", nil)
+	got, err := transport.Proxy(req)
+	assert.NoError(t, err)
+	assert.Equal(t, proxyURL, got)
+}
+
+func TestNewClientWithLazyAuth(t *testing.T) {
+	var logins int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			atomic.AddInt32(&logins, 1)
+			time.Sleep(10 * time.Millisecond)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   map[string]string{"token": "test-token"},
+			})
+			return
+		}
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		json.NewEncoder(w).Encode(map[string]string{"data": "test"})
+	}))
+	defer ts.Close()
+
+	client, err := NewClient("test-api-key", WithBaseURL(ts.URL), WithLazyAuth())
+	assert.NoError(t, err)
+	assert.False(t, client.Auth.IsAuthenticated())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&logins))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var result map[string]string
+			assert.NoError(t, client.Get("/test", &result))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&logins))
+}
+
+// staticTokenStore is a TokenStore that returns the same token for every key.
+type staticTokenStore string
+
+func (s staticTokenStore) Load(key string) (string, error) { return string(s), nil }
+
+func (s staticTokenStore) Save(key, token string) error { return nil }
+
+func TestNewClientWithTokenStore(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEqual(t, "/login", r.URL.Path)
+		assert.Equal(t, "Bearer stored-token", r.Header.Get("Authorization"))
+		json.NewEncoder(w).Encode(map[string]string{"data": "test"})
+	}))
+	defer ts.Close()
+
+	client, err := NewClient("test-api-key", WithBaseURL(ts.URL), WithTokenStore(staticTokenStore("stored-token")))
+	assert.NoError(t, err)
+
+	var result map[string]string
+	assert.NoError(t, client.Get("/test", &result))
+}
+
+func TestAuthenticateWithTokenStoreRelogin(t *testing.T) {
+	var logins int32
+	var current atomic.Value
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			token := fmt.Sprintf("token-%d", atomic.AddInt32(&logins, 1))
+			current.Store(token)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   map[string]string{"token": token},
+			})
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer "+current.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"data": "test"})
+	}))
+	defer ts.Close()
+
+	client, err := NewClient("test-api-key", WithBaseURL(ts.URL), WithLazyAuth(), WithTokenStore(auth.NewMemoryTokenStore()))
+	assert.NoError(t, err)
+	assert.NoError(t, client.Authenticate(context.Background()))
+
+	// The server revokes the token, which is still in the store
+	current.Store("revoked")
+
+	var result map[string]string
+	assert.NoError(t, client.Get("/test", &result))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&logins))
+}
+
+func TestAuthenticate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient("test-api-key", WithBaseURL(ts.URL), WithLazyAuth(), WithRetryMax(0))
+	assert.NoError(t, err)
+
+	err = client.Authenticate(context.Background())
+	assert.ErrorContains(t, err, "failed to authenticate")
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestGetContextCanceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	}))
+	defer ts.Close()
+
+	client, _ := newTestClient("test-api-key", ts.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var result map[string]string
+	err := client.GetContext(ctx, "/test", &result)
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDoRequestContextRefreshesTokenOnUnauthorized(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   map[string]string{"token": "new-token"},
+			})
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client, _ := newTestClient("test-api-key", ts.URL)
+	client.Auth = auth.NewAuthWithBaseURL("test-api-key", ts.URL)
+	client.Auth.SetToken("expired-token")
+
+	resp, err := client.DoRequestContext(context.Background(), "GET", "/test", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "new-token", client.Auth.Token())
+}
+
+func TestConcurrentUnauthorizedRequestsShareRefresh(t *testing.T) {
+	var logins int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			atomic.AddInt32(&logins, 1)
+			time.Sleep(10 * time.Millisecond)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": "success",
+				"data":   map[string]string{"token": "new-token"},
+			})
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"data": "test"})
+	}))
+	defer ts.Close()
+
+	client, err := NewClient("test-api-key", WithBaseURL(ts.URL), WithLazyAuth())
+	assert.NoError(t, err)
+	client.Auth.SetToken("expired-token")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var result map[string]string
+			assert.NoError(t, client.Get("/test", &result))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&logins))
+}
+
+func TestRateLimit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"data": "test"})
+	}))
+	defer ts.Close()
+
+	client, _ := newTestClient("test-api-key", ts.URL)
+	client.httpClient.HTTPClient.Transport = &rateLimitTransport{limiter: newRateLimiter(20, 2)}
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		var result map[string]string
+		assert.NoError(t, client.Get("/test", &result))
+	}
+
+	// Two requests fit in the burst, the other two wait 50ms each
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestRateLimitRetries(t *testing.T) {
+	var attempts int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"data": "test"})
+	}))
+	defer ts.Close()
+
+	client, _ := newTestClient("test-api-key", ts.URL)
+	client.httpClient.HTTPClient.Transport = &rateLimitTransport{limiter: newRateLimiter(20, 1)}
+
+	start := time.Now()
+	var result map[string]string
+	assert.NoError(t, client.Get("/test", &result))
+
+	// Each retry waits 50ms for the limiter on top of the backoff
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// Requests through HTTPClient are not limited
+	start = time.Now()
+	for i := 0; i < 3; i++ {
+		resp, err := client.HTTPClient().Get(ts.URL + "/image.jpg")
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestRateLimitExceedsDeadline(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"data": "test"})
+	}))
+	defer ts.Close()
+
+	client, _ := newTestClient("test-api-key", ts.URL)
+	client.httpClient.HTTPClient.Transport = &rateLimitTransport{limiter: newRateLimiter(1, 1)}
+
+	var result map[string]string
+	assert.NoError(t, client.Get("/test", &result))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := client.GetContext(ctx, "/test", &result)
+
+	var rateErr *RateLimitError
+	assert.ErrorAs(t, err, &rateErr)
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.Greater(t, rateErr.RetryAfter, 100*time.Millisecond)
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter string
+		timeout    time.Duration
+		wantErr    bool
+	}{
+		{name: "Retry within deadline", retryAfter: "0", timeout: time.Second},
+		{name: "Retry without deadline", retryAfter: "0"},
+		{name: "Retry after deadline", retryAfter: "120", timeout: time.Second, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) == 1 {
+					w.Header().Set("Retry-After", tt.retryAfter)
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				json.NewEncoder(w).Encode(map[string]string{"data": "test"})
+			}))
+			defer ts.Close()
+
+			client, _ := newTestClient("test-api-key", ts.URL)
+
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			var result map[string]string
+			err := client.GetContext(ctx, "/test", &result)
+
+			if tt.wantErr {
+				var rateErr *RateLimitError
+				assert.ErrorAs(t, err, &rateErr)
+				assert.Equal(t, 120*time.Second, rateErr.RetryAfter)
+				assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("30")
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, wait)
+
+	wait, ok = parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.InDelta(t, time.Minute, wait, float64(2*time.Second))
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
+
+func TestAcceptLanguage(t *testing.T) {
+	var got []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Accept-Language"))
+		w.Write([]byte(`{"data": "test"}`))
+	}))
+	defer ts.Close()
+
+	client, _ := newTestClient("test-api-key", ts.URL)
+	client.languages = []string{"deu", "eng"}
+
+	var result map[string]interface{}
+	assert.NoError(t, client.Get("/test", &result))
+	assert.NoError(t, client.GetContext(ContextWithLanguages(context.Background(), "fra"), "/test", &result))
+	assert.NoError(t, client.GetContext(ContextWithLanguages(context.Background()), "/test", &result))
+
+	assert.Equal(t, []string{"deu, eng", "fra", ""}, got)
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/LaughinKuma/tvdb-go-api/internal/apierror"
+)
+
+// APIError is returned when the TVDB API, including its login endpoint,
+// responds with a non-2xx status code.
+type APIError = apierror.APIError
+
+// Sentinel errors matched by APIError via errors.Is.
+var (
+	ErrNotFound     = apierror.ErrNotFound
+	ErrUnauthorized = apierror.ErrUnauthorized
+	ErrRateLimited  = apierror.ErrRateLimited
+	ErrServer       = apierror.ErrServer
+)
+
+// RateLimitError is returned when a request cannot be sent, or retried after
+// a 429 response, before the caller's context deadline because of rate
+// limiting. It matches ErrRateLimited.
+type RateLimitError struct {
+	// RetryAfter is how long the request would have had to wait.
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface.
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited: retry after %s exceeds context deadline", e.RetryAfter)
+}
+
+// Is reports whether target is ErrRateLimited.
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// checkResponse returns an *APIError if resp does not have a 2xx status code.
+// It reads part of the body but does not close it.
+func checkResponse(resp *http.Response, method, path string) error {
+	return apierror.CheckResponse(resp, method, path)
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"strings"
+)
+
+type languagesKey struct{}
+
+// ContextWithLanguages returns a context whose requests prefer the given
+// three-letter language codes, most preferred first, over the languages set
+// with WithLanguages. Passing no languages disables localization for
+// requests made with the context.
+func ContextWithLanguages(ctx context.Context, languages ...string) context.Context {
+	return context.WithValue(ctx, languagesKey{}, languages)
+}
+
+// LanguagesFromContext returns the languages set with ContextWithLanguages.
+// It reports false if none were set.
+func LanguagesFromContext(ctx context.Context) ([]string, bool) {
+	languages, ok := ctx.Value(languagesKey{}).([]string)
+	return languages, ok
+}
+
+// Languages returns the preferred languages for requests made with ctx:
+// those set with ContextWithLanguages, or else those set with WithLanguages.
+func (c *Client) Languages(ctx context.Context) []string {
+	if languages, ok := LanguagesFromContext(ctx); ok {
+		return languages
+	}
+	return c.languages
+}
+
+// acceptLanguage formats languages as an Accept-Language header value.
+func acceptLanguage(languages []string) string {
+	return strings.Join(languages, ", ")
+}
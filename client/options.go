@@ -0,0 +1,216 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/LaughinKuma/tvdb-go-api/auth"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	defaultRetryMax     = 3
+	defaultRetryWaitMin = 1 * time.Second
+	defaultRetryWaitMax = 5 * time.Second
+)
+
+// Option configures a Client created by NewClient.
+type Option func(*options)
+
+type options struct {
+	baseURL      string
+	httpClient   *http.Client
+	transport    http.RoundTripper
+	proxy        *url.URL
+	timeout      time.Duration
+	retryMax     int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+	userAgent    string
+	lazyAuth     bool
+	pin          string
+	tokenStore   auth.TokenStore
+	rateLimit    float64
+	rateBurst    int
+	languages    []string
+}
+
+func defaultOptions() *options {
+	return &options{
+		baseURL:      auth.DefaultBaseURL,
+		retryMax:     defaultRetryMax,
+		retryWaitMin: defaultRetryWaitMin,
+		retryWaitMax: defaultRetryWaitMax,
+	}
+}
+
+// WithBaseURL sets the base URL used for both API requests and login.
+func WithBaseURL(baseURL string) Option {
+	return func(o *options) {
+		o.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets the underlying *http.Client used to send requests.
+// The client is copied, so later changes to it have no effect.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithTransport sets the http.RoundTripper used to send requests.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *options) {
+		o.transport = transport
+	}
+}
+
+// WithProxy routes all requests through the given proxy. It requires the
+// transport to be an *http.Transport, which is the default.
+func WithProxy(proxyURL *url.URL) Option {
+	return func(o *options) {
+		o.proxy = proxyURL
+	}
+}
+
+// WithTimeout sets the timeout for each individual HTTP attempt.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithRetryMax sets the maximum number of retries for a failed request.
+func WithRetryMax(retryMax int) Option {
+	return func(o *options) {
+		o.retryMax = retryMax
+	}
+}
+
+// WithRetryWait sets the minimum and maximum backoff between retries.
+func WithRetryWait(min, max time.Duration) Option {
+	return func(o *options) {
+		o.retryWaitMin = min
+		o.retryWaitMax = max
+	}
+}
+
+// WithRateLimit limits the client to requestsPerSecond requests on average,
+// allowing bursts of up to burst requests. The limit is shared by all
+// goroutines using the client and applies to every attempt, including
+// retries and logins.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(o *options) {
+		o.rateLimit = requestsPerSecond
+		o.rateBurst = burst
+	}
+}
+
+// WithLanguages sets the three-letter language codes, most preferred first,
+// that records are localized to. It can be overridden per call with
+// ContextWithLanguages.
+func WithLanguages(languages ...string) Option {
+	return func(o *options) {
+		o.languages = languages
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithPIN sets the subscriber PIN required by user-supported API keys.
+func WithPIN(pin string) Option {
+	return func(o *options) {
+		o.pin = pin
+	}
+}
+
+// WithTokenStore sets a store used to reuse tokens across process restarts,
+// so that NewClient only logs in when the stored token is missing or expiring.
+func WithTokenStore(store auth.TokenStore) Option {
+	return func(o *options) {
+		o.tokenStore = store
+	}
+}
+
+// WithLazyAuth defers login until the first request instead of logging in
+// inside NewClient. Use Client.Authenticate to log in explicitly.
+func WithLazyAuth() Option {
+	return func(o *options) {
+		o.lazyAuth = true
+	}
+}
+
+// newHTTPClient builds the retrying HTTP client shared by Client and Auth.
+func newHTTPClient(o *options) (*retryablehttp.Client, error) {
+	rc := retryablehttp.NewClient()
+	rc.RetryMax = o.retryMax
+	rc.RetryWaitMin = o.retryWaitMin
+	rc.RetryWaitMax = o.retryWaitMax
+	rc.CheckRetry = checkRetry
+	// Hand the last response back once retries are exhausted so that its
+	// status and body can be reported in an APIError.
+	rc.ErrorHandler = retryablehttp.PassthroughErrorHandler
+
+	if o.httpClient != nil {
+		hc := *o.httpClient
+		rc.HTTPClient = &hc
+	}
+	hc := rc.HTTPClient
+
+	if o.transport != nil {
+		hc.Transport = o.transport
+	}
+
+	if o.proxy != nil {
+		transport := hc.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		t, ok := transport.(*http.Transport)
+		if !ok {
+			return nil, errors.New("proxy option requires an *http.Transport")
+		}
+		t = t.Clone()
+		t.Proxy = http.ProxyURL(o.proxy)
+		hc.Transport = t
+	}
+
+	if o.timeout > 0 {
+		hc.Timeout = o.timeout
+	}
+
+	if o.userAgent != "" {
+		hc.Transport = &userAgentTransport{base: hc.Transport, userAgent: o.userAgent}
+	}
+
+	if o.rateLimit > 0 {
+		// Limiting in the transport counts every retry and login attempt
+		hc.Transport = &rateLimitTransport{base: hc.Transport, limiter: newRateLimiter(o.rateLimit, o.rateBurst)}
+	}
+
+	return rc, nil
+}
+
+// userAgentTransport sets the User-Agent header on every outgoing request.
+type userAgentTransport struct {
+	base      http.RoundTripper
+	userAgent string
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// rateLimiter is a token bucket shared by all requests sent by a Client.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request may be sent. If ctx has a deadline that would
+// pass before then, it returns a *RateLimitError without waiting.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	// Reserve a token up front so that concurrent waiters queue up fairly
+	l.tokens--
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	if deadline, ok := ctx.Deadline(); ok && wait > 0 && now.Add(wait).After(deadline) {
+		l.tokens++
+		l.mu.Unlock()
+		return &RateLimitError{RetryAfter: wait}
+	}
+	l.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// rateLimitTransport waits for the rate limiter before every request it sends.
+type rateLimitTransport struct {
+	base    http.RoundTripper
+	limiter *rateLimiter
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if req.Context().Value(unlimitedKey{}) == nil {
+		if err := t.limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return base.RoundTrip(req)
+}
+
+// unlimitedKey marks request contexts that bypass the rate limiter.
+type unlimitedKey struct{}
+
+// unlimitedTransport sends requests past the rate limiter, for requests that
+// do not go to the TVDB API.
+type unlimitedTransport struct {
+	base http.RoundTripper
+}
+
+func (t *unlimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.base.RoundTrip(req.WithContext(context.WithValue(req.Context(), unlimitedKey{}, true)))
+}
+
+// checkRetry extends retryablehttp.DefaultRetryPolicy so that a Retry-After
+// delay that would outlast the request's context deadline stops retrying
+// instead of sleeping until the context expires.
+func checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	// Waiting longer would not help a request the rate limiter refused
+	var rateErr *RateLimitError
+	if errors.As(err, &rateErr) {
+		return false, rateErr
+	}
+
+	retry, checkErr := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	if !retry || resp == nil {
+		return retry, checkErr
+	}
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return retry, checkErr
+	}
+
+	wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"))
+	if !ok {
+		return retry, checkErr
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return false, &RateLimitError{RetryAfter: wait}
+		}
+		// Stop retrying and let the caller see the 503 response
+		return false, nil
+	}
+	return retry, checkErr
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
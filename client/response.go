@@ -0,0 +1,23 @@
+package client
+
+import (
+	"context"
+
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// Getter is implemented by clients that can perform GET requests.
+type Getter interface {
+	GetContext(ctx context.Context, path string, result interface{}) error
+}
+
+// GetResponse performs a GET request to path and decodes the TVDB response
+// envelope around data of type T. Status checking and error typing are done
+// by the client, so every caller gets the same behaviour.
+func GetResponse[T any](ctx context.Context, c Getter, path string) (*models.Response[T], error) {
+	var response models.Response[T]
+	if err := c.GetContext(ctx, path, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
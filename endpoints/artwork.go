@@ -0,0 +1,92 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LaughinKuma/tvdb-go-api/client"
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// GetArtworkByID fetches an artwork by its ID.
+func GetArtworkByID(c client.ClientInterface, id int) (*models.Artwork, error) {
+	return GetArtworkByIDContext(context.Background(), c, id)
+}
+
+// GetArtworkByIDContext is like GetArtworkByID but uses the provided context.
+func GetArtworkByIDContext(ctx context.Context, c client.ClientInterface, id int) (*models.Artwork, error) {
+	path := fmt.Sprintf("/artwork/%d", id)
+
+	response, err := client.GetResponse[models.Artwork](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get artwork: %w", err)
+	}
+
+	return &response.Data, nil
+}
+
+// GetArtworkExtended fetches an artwork with the record it belongs to and its status.
+func GetArtworkExtended(c client.ClientInterface, id int) (*models.ArtworkExtended, error) {
+	return GetArtworkExtendedContext(context.Background(), c, id)
+}
+
+// GetArtworkExtendedContext is like GetArtworkExtended but uses the provided context.
+func GetArtworkExtendedContext(ctx context.Context, c client.ClientInterface, id int) (*models.ArtworkExtended, error) {
+	path := fmt.Sprintf("/artwork/%d/extended", id)
+
+	response, err := client.GetResponse[models.ArtworkExtended](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get extended artwork: %w", err)
+	}
+
+	return &response.Data, nil
+}
+
+// GetSeriesArtworks fetches the artworks of a series, optionally filtered by
+// language and type.
+func GetSeriesArtworks(c client.ClientInterface, seriesID int, opts *ArtworkOptions) ([]models.Artwork, error) {
+	return GetSeriesArtworksContext(context.Background(), c, seriesID, opts)
+}
+
+// GetSeriesArtworksContext is like GetSeriesArtworks but uses the provided context.
+func GetSeriesArtworksContext(ctx context.Context, c client.ClientInterface, seriesID int, opts *ArtworkOptions) ([]models.Artwork, error) {
+	path := fmt.Sprintf("/series/%d/artworks%s", seriesID, opts.query())
+
+	// The response is the series record with only the matching artworks
+	response, err := client.GetResponse[models.SeriesExtended](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get series artworks: %w", err)
+	}
+
+	return response.Data.Artworks, nil
+}
+
+// GetArtworkTypes fetches the catalogue of artwork types.
+func GetArtworkTypes(c client.ClientInterface) ([]models.ArtworkType, error) {
+	return GetArtworkTypesContext(context.Background(), c)
+}
+
+// GetArtworkTypesContext is like GetArtworkTypes but uses the provided context.
+func GetArtworkTypesContext(ctx context.Context, c client.ClientInterface) ([]models.ArtworkType, error) {
+	response, err := client.GetResponse[[]models.ArtworkType](ctx, c, "/artwork/types")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get artwork types: %w", err)
+	}
+
+	return response.Data, nil
+}
+
+// GetArtworkStatuses fetches the catalogue of artwork statuses.
+func GetArtworkStatuses(c client.ClientInterface) ([]models.ArtworkStatus, error) {
+	return GetArtworkStatusesContext(context.Background(), c)
+}
+
+// GetArtworkStatusesContext is like GetArtworkStatuses but uses the provided context.
+func GetArtworkStatusesContext(ctx context.Context, c client.ClientInterface) ([]models.ArtworkStatus, error) {
+	response, err := client.GetResponse[[]models.ArtworkStatus](ctx, c, "/artwork/statuses")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get artwork statuses: %w", err)
+	}
+
+	return response.Data, nil
+}
@@ -0,0 +1,26 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LaughinKuma/tvdb-go-api/client"
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// GetCharacterByID fetches a character by its ID.
+func GetCharacterByID(c client.ClientInterface, id int) (*models.Character, error) {
+	return GetCharacterByIDContext(context.Background(), c, id)
+}
+
+// GetCharacterByIDContext is like GetCharacterByID but uses the provided context.
+func GetCharacterByIDContext(ctx context.Context, c client.ClientInterface, id int) (*models.Character, error) {
+	path := fmt.Sprintf("/characters/%d", id)
+
+	response, err := client.GetResponse[models.Character](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get character: %w", err)
+	}
+
+	return &response.Data, nil
+}
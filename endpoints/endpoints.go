@@ -2,9 +2,10 @@
 package endpoints
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
+	"net/url"
+	"sync"
 
 	"github.com/LaughinKuma/tvdb-go-api/client"
 	"github.com/LaughinKuma/tvdb-go-api/models"
@@ -12,85 +13,390 @@ import (
 
 // GetSeriesByID fetches a series by its ID.
 func GetSeriesByID(c client.ClientInterface, id int) (*models.Series, error) {
+	return GetSeriesByIDContext(context.Background(), c, id)
+}
+
+// GetSeriesByIDContext is like GetSeriesByID but uses the provided context.
+func GetSeriesByIDContext(ctx context.Context, c client.ClientInterface, id int) (*models.Series, error) {
 	path := fmt.Sprintf("/series/%d", id)
-	
-	var response struct {
-		Data models.Series `json:"data"`
+
+	response, err := client.GetResponse[models.Series](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get series: %w", err)
+	}
+
+	if err := localizeSeries(ctx, c, &response.Data, nil); err != nil {
+		return nil, fmt.Errorf("failed to localize series: %w", err)
 	}
 
-	err := c.Get(path, &response)
+	return &response.Data, nil
+}
+
+// GetSeriesBySlug fetches a series by its URL slug, e.g. "breaking-bad".
+func GetSeriesBySlug(c client.ClientInterface, slug string) (*models.Series, error) {
+	return GetSeriesBySlugContext(context.Background(), c, slug)
+}
+
+// GetSeriesBySlugContext is like GetSeriesBySlug but uses the provided context.
+func GetSeriesBySlugContext(ctx context.Context, c client.ClientInterface, slug string) (*models.Series, error) {
+	path := fmt.Sprintf("/series/slug/%s", url.PathEscape(slug))
+
+	response, err := client.GetResponse[models.Series](ctx, c, path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get series: %w", err)
 	}
 
+	if err := localizeSeries(ctx, c, &response.Data, nil); err != nil {
+		return nil, fmt.Errorf("failed to localize series: %w", err)
+	}
+
+	return &response.Data, nil
+}
+
+// GetSeriesExtended fetches a series with its artworks, characters, seasons,
+// remote IDs, companies, trailers and tags.
+func GetSeriesExtended(c client.ClientInterface, id int, opts *ExtendedOptions) (*models.SeriesExtended, error) {
+	return GetSeriesExtendedContext(context.Background(), c, id, opts)
+}
+
+// GetSeriesExtendedContext is like GetSeriesExtended but uses the provided context.
+func GetSeriesExtendedContext(ctx context.Context, c client.ClientInterface, id int, opts *ExtendedOptions) (*models.SeriesExtended, error) {
+	path := fmt.Sprintf("/series/%d/extended%s", id, opts.query())
+
+	response, err := client.GetResponse[models.SeriesExtended](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get extended series: %w", err)
+	}
+
+	if err := localizeSeries(ctx, c, &response.Data.Series, response.Data.Translations); err != nil {
+		return nil, fmt.Errorf("failed to localize series: %w", err)
+	}
+
 	return &response.Data, nil
 }
 
 // GetSeriesEpisodes fetches episodes for a series.
 func GetSeriesEpisodes(c client.ClientInterface, seriesID int, seasonType string, page int) ([]models.Episode, int, int, error) {
-    path := fmt.Sprintf("/series/%d/episodes/%s?page=%d", seriesID, seasonType, page)
-    
-    resp, err := c.DoRequest("GET", path, nil)
-    if err != nil {
-        return nil, 0, 0, fmt.Errorf("failed to get series episodes: %w", err)
-    }
-    defer resp.Body.Close()
+	return GetSeriesEpisodesContext(context.Background(), c, seriesID, seasonType, page)
+}
+
+// GetSeriesEpisodesContext is like GetSeriesEpisodes but uses the provided context.
+func GetSeriesEpisodesContext(ctx context.Context, c client.ClientInterface, seriesID int, seasonType string, page int) ([]models.Episode, int, int, error) {
+	response, err := getSeriesEpisodesPage(ctx, c, seriesID, seasonType, page)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	return response.Data.Episodes, response.Links.TotalItems, response.Links.PageSize, nil
+}
+
+// getSeriesEpisodesPage fetches one page of episodes. When a language is
+// preferred, the episodes are listed in the most preferred one only; unlike
+// single records, episodes missing that translation are not localized to the
+// next preferred language. The language is recorded in Localization only for
+// the fields an episode has a translation for.
+func getSeriesEpisodesPage(ctx context.Context, c client.ClientInterface, seriesID int, seasonType string, page int) (*models.SeriesEpisodesResponse, error) {
+	path := fmt.Sprintf("/series/%d/episodes/%s?page=%d", seriesID, seasonType, page)
+
+	language := ""
+	if languages := preferredLanguages(ctx, c); len(languages) > 0 {
+		language = languages[0]
+		path = fmt.Sprintf("/series/%d/episodes/%s/%s?page=%d", seriesID, seasonType, url.PathEscape(language), page)
+	}
+
+	response, err := client.GetResponse[models.SeriesEpisodes](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get series episodes: %w", err)
+	}
+
+	if language != "" {
+		for i := range response.Data.Episodes {
+			episode := &response.Data.Episodes[i]
+			if pickLanguage([]string{language}, episode.NameTranslations) != "" {
+				episode.Localization.Name = language
+			}
+			if pickLanguage([]string{language}, episode.OverviewTranslations) != "" {
+				episode.Localization.Overview = language
+			}
+		}
+	}
+
+	return response, nil
+}
+
+// SeriesEpisodesIterator iterates over all episodes of a series, fetching
+// pages by following links.next as needed. Stop calling Next to end early.
+//
+//	it := endpoints.NewSeriesEpisodesIterator(ctx, c, seriesID, "default")
+//	for it.Next() {
+//		episode := it.Episode()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type SeriesEpisodesIterator struct {
+	pager *pager[models.Episode]
+}
 
-    bodyBytes, _ := io.ReadAll(resp.Body)
+// NewSeriesEpisodesIterator returns an iterator over the episodes of a series,
+// starting at the first page. No request is made until Next is called.
+func NewSeriesEpisodesIterator(ctx context.Context, c client.ClientInterface, seriesID int, seasonType string) *SeriesEpisodesIterator {
+	return newSeriesEpisodesIterator(ctx, c, seriesID, seasonType, 0)
+}
 
-    var response models.SeriesEpisodesResponse
-    err = json.Unmarshal(bodyBytes, &response)
-    if err != nil {
-        return nil, 0, 0, fmt.Errorf("failed to unmarshal response: %w", err)
-    }
+// newSeriesEpisodesIterator returns an iterator starting at the given page.
+func newSeriesEpisodesIterator(ctx context.Context, c client.ClientInterface, seriesID int, seasonType string, page int) *SeriesEpisodesIterator {
+	fetch := func(ctx context.Context, page int) ([]models.Episode, models.Links, error) {
+		response, err := getSeriesEpisodesPage(ctx, c, seriesID, seasonType, page)
+		if err != nil {
+			return nil, models.Links{}, err
+		}
+		return response.Data.Episodes, response.Links, nil
+	}
+	p := newPager(ctx, fetch)
+	p.page = page
+	return &SeriesEpisodesIterator{pager: p}
+}
 
-    return response.Data.Episodes, response.Links.TotalItems, response.Links.PageSize, nil
+// Next advances to the next episode. It returns false when there are no more
+// episodes or an error occurred.
+func (it *SeriesEpisodesIterator) Next() bool {
+	return it.pager.next()
+}
+
+// Episode returns the current episode.
+func (it *SeriesEpisodesIterator) Episode() models.Episode {
+	return it.pager.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *SeriesEpisodesIterator) Err() error {
+	return it.pager.err
+}
+
+// GetAllSeriesEpisodes fetches every episode of a series. With a concurrency
+// above 1, the remaining pages are fetched in parallel once the first page
+// reveals the total number of episodes.
+func GetAllSeriesEpisodes(c client.ClientInterface, seriesID int, seasonType string, concurrency int) ([]models.Episode, error) {
+	return GetAllSeriesEpisodesContext(context.Background(), c, seriesID, seasonType, concurrency)
+}
+
+// GetAllSeriesEpisodesContext is like GetAllSeriesEpisodes but uses the
+// provided context. Cancelling it stops any outstanding page requests.
+func GetAllSeriesEpisodesContext(ctx context.Context, c client.ClientInterface, seriesID int, seasonType string, concurrency int) ([]models.Episode, error) {
+	if concurrency <= 1 {
+		return collectSeriesEpisodes(ctx, c, seriesID, seasonType, nil, 0)
+	}
+
+	first, err := getSeriesEpisodesPage(ctx, c, seriesID, seasonType, 0)
+	if err != nil {
+		return nil, err
+	}
+	next, ok := nextPage(first.Links)
+	if !ok {
+		return first.Data.Episodes, nil
+	}
+
+	count := pageCount(first.Links)
+	if count < 2 {
+		// total_items is missing or contradicts links.next, so the pages
+		// cannot be fetched in parallel; follow the links instead
+		return collectSeriesEpisodes(ctx, c, seriesID, seasonType, first.Data.Episodes, next)
+	}
+
+	pages := make([][]models.Episode, count)
+	pages[0] = first.Data.Episodes
+	var lastLinks models.Links
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+	for page := 1; page < len(pages) && ctx.Err() == nil; page++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(page int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			response, err := getSeriesEpisodesPage(ctx, c, seriesID, seasonType, page)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+			pages[page] = response.Data.Episodes
+			if page == len(pages)-1 {
+				lastLinks = response.Links
+			}
+		}(page)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var episodes []models.Episode
+	for _, page := range pages {
+		episodes = append(episodes, page...)
+	}
+
+	// total_items was too low if the last expected page links to another
+	if next, ok := nextPage(lastLinks); ok && next >= len(pages) {
+		return collectSeriesEpisodes(ctx, c, seriesID, seasonType, episodes, next)
+	}
+	return episodes, nil
+}
+
+// collectSeriesEpisodes appends the episodes of every page from the given
+// one onwards to episodes, following links.next.
+func collectSeriesEpisodes(ctx context.Context, c client.ClientInterface, seriesID int, seasonType string, episodes []models.Episode, page int) ([]models.Episode, error) {
+	it := newSeriesEpisodesIterator(ctx, c, seriesID, seasonType, page)
+	for it.Next() {
+		episodes = append(episodes, it.Episode())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return episodes, nil
 }
 
 // GetEpisodeByID fetches an episode by its ID.
 func GetEpisodeByID(c client.ClientInterface, id int) (*models.Episode, error) {
+	return GetEpisodeByIDContext(context.Background(), c, id)
+}
+
+// GetEpisodeByIDContext is like GetEpisodeByID but uses the provided context.
+func GetEpisodeByIDContext(ctx context.Context, c client.ClientInterface, id int) (*models.Episode, error) {
 	path := fmt.Sprintf("/episodes/%d", id)
-	
-	var response struct {
-		Data models.Episode `json:"data"`
-	}
 
-	err := c.Get(path, &response)
+	response, err := client.GetResponse[models.Episode](ctx, c, path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get episode: %w", err)
 	}
 
+	if err := localizeEpisode(ctx, c, &response.Data, nil); err != nil {
+		return nil, fmt.Errorf("failed to localize episode: %w", err)
+	}
+
 	return &response.Data, nil
 }
 
-// GetSeriesSeasons fetches seasons for a series.
-func GetSeriesSeasons(c client.ClientInterface, seriesID int) ([]models.Season, error) {
-	path := fmt.Sprintf("/series/%d/seasons", seriesID)
-	
-	var response struct {
-		Data []models.Season `json:"data"`
+// GetEpisodeExtended fetches an episode with its characters, networks,
+// companies, trailers, awards and remote IDs.
+func GetEpisodeExtended(c client.ClientInterface, id int, opts *ExtendedOptions) (*models.EpisodeExtended, error) {
+	return GetEpisodeExtendedContext(context.Background(), c, id, opts)
+}
+
+// GetEpisodeExtendedContext is like GetEpisodeExtended but uses the provided context.
+func GetEpisodeExtendedContext(ctx context.Context, c client.ClientInterface, id int, opts *ExtendedOptions) (*models.EpisodeExtended, error) {
+	path := fmt.Sprintf("/episodes/%d/extended%s", id, opts.query())
+
+	response, err := client.GetResponse[models.EpisodeExtended](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get extended episode: %w", err)
+	}
+
+	if err := localizeEpisode(ctx, c, &response.Data.Episode, response.Data.Translations); err != nil {
+		return nil, fmt.Errorf("failed to localize episode: %w", err)
 	}
 
-	err := c.Get(path, &response)
+	return &response.Data, nil
+}
+
+// GetSeriesSeasons fetches seasons for a series, in every episode ordering.
+func GetSeriesSeasons(c client.ClientInterface, seriesID int) ([]models.Season, error) {
+	return GetSeriesSeasonsContext(context.Background(), c, seriesID)
+}
+
+// GetSeriesSeasonsContext is like GetSeriesSeasons but uses the provided context.
+func GetSeriesSeasonsContext(ctx context.Context, c client.ClientInterface, seriesID int) ([]models.Season, error) {
+	// TVDB v4 has no seasons list endpoint; the short extended series record
+	// includes the seasons without characters, artworks and trailers. The
+	// series itself is discarded, so it is not localized.
+	ctx = client.ContextWithLanguages(ctx)
+	series, err := GetSeriesExtendedContext(ctx, c, seriesID, &ExtendedOptions{Short: true})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get series seasons: %w", err)
 	}
 
-	return response.Data, nil
+	return series.Seasons, nil
 }
 
 // GetMovieByID fetches a movie by its ID.
 func GetMovieByID(c client.ClientInterface, id int) (*models.Movie, error) {
+	return GetMovieByIDContext(context.Background(), c, id)
+}
+
+// GetMovieByIDContext is like GetMovieByID but uses the provided context.
+func GetMovieByIDContext(ctx context.Context, c client.ClientInterface, id int) (*models.Movie, error) {
 	path := fmt.Sprintf("/movies/%d", id)
-	
-	var response struct {
-		Data models.Movie `json:"data"`
+
+	response, err := client.GetResponse[models.Movie](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get movie: %w", err)
 	}
 
-	err := c.Get(path, &response)
+	if err := localizeMovie(ctx, c, &response.Data, nil); err != nil {
+		return nil, fmt.Errorf("failed to localize movie: %w", err)
+	}
+
+	return &response.Data, nil
+}
+
+// GetMovieBySlug fetches a movie by its URL slug, e.g. "the-matrix".
+func GetMovieBySlug(c client.ClientInterface, slug string) (*models.Movie, error) {
+	return GetMovieBySlugContext(context.Background(), c, slug)
+}
+
+// GetMovieBySlugContext is like GetMovieBySlug but uses the provided context.
+func GetMovieBySlugContext(ctx context.Context, c client.ClientInterface, slug string) (*models.Movie, error) {
+	path := fmt.Sprintf("/movies/slug/%s", url.PathEscape(slug))
+
+	response, err := client.GetResponse[models.Movie](ctx, c, path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get movie: %w", err)
 	}
 
+	if err := localizeMovie(ctx, c, &response.Data, nil); err != nil {
+		return nil, fmt.Errorf("failed to localize movie: %w", err)
+	}
+
 	return &response.Data, nil
-}
\ No newline at end of file
+}
+
+// GetMovieExtended fetches a movie with its releases, box office, studios,
+// production countries, characters, artworks, trailers, lists and remote IDs.
+func GetMovieExtended(c client.ClientInterface, id int, opts *ExtendedOptions) (*models.MovieExtended, error) {
+	return GetMovieExtendedContext(context.Background(), c, id, opts)
+}
+
+// GetMovieExtendedContext is like GetMovieExtended but uses the provided context.
+func GetMovieExtendedContext(ctx context.Context, c client.ClientInterface, id int, opts *ExtendedOptions) (*models.MovieExtended, error) {
+	path := fmt.Sprintf("/movies/%d/extended%s", id, opts.query())
+
+	response, err := client.GetResponse[models.MovieExtended](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get extended movie: %w", err)
+	}
+
+	if err := localizeMovie(ctx, c, &response.Data.Movie, response.Data.Translations); err != nil {
+		return nil, fmt.Errorf("failed to localize movie: %w", err)
+	}
+
+	return &response.Data, nil
+}
@@ -1,13 +1,19 @@
 package endpoints
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/LaughinKuma/tvdb-go-api/client"
 	"github.com/LaughinKuma/tvdb-go-api/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockClient is a mock implementation of the client.Client
@@ -16,21 +22,32 @@ type MockClient struct {
 }
 
 func (m *MockClient) Get(path string, result interface{}) error {
-	args := m.Called(path, result)
+	return m.GetContext(context.Background(), path, result)
+}
+
+func (m *MockClient) GetContext(ctx context.Context, path string, result interface{}) error {
+	args := m.Called(ctx, path, result)
 	return args.Error(0)
 }
 
 func (m *MockClient) DoRequest(method, path string, body io.Reader) (*http.Response, error) {
-	args := m.Called(method, path, body)
+	return m.DoRequestContext(context.Background(), method, path, body)
+}
+
+func (m *MockClient) DoRequestContext(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+	args := m.Called(ctx, method, path, body)
 	return args.Get(0).(*http.Response), args.Error(1)
 }
 
 func (m *MockClient) Post(path string, body interface{}, result interface{}) error {
-    args := m.Called(path, body, result)
-    return args.Error(0)
+	return m.PostContext(context.Background(), path, body, result)
+}
+
+func (m *MockClient) PostContext(ctx context.Context, path string, body interface{}, result interface{}) error {
+	args := m.Called(ctx, path, body, result)
+	return args.Error(0)
 }
 
-// Add the missing SetBaseURL method
 func (m *MockClient) SetBaseURL(url string) {
 	m.Called(url)
 }
@@ -40,16 +57,13 @@ func TestGetSeriesByID(t *testing.T) {
 	seriesID := 123
 	expectedSeries := &models.Series{ID: seriesID, Name: "Test Series"}
 
-	mockClient.On("Get", "/series/123", mock.AnythingOfType("*struct { Data models.Series }")).
+	mockClient.On("GetContext", mock.Anything, "/series/123", mock.AnythingOfType("*models.Response[github.com/LaughinKuma/tvdb-go-api/models.Series]")).
 		Run(func(args mock.Arguments) {
-			arg := args.Get(1).(*struct{ Data models.Series })
+			arg := args.Get(2).(*models.Response[models.Series])
 			arg.Data = *expectedSeries
 		}).
 		Return(nil)
 
-	// Add expectation for SetBaseURL if it's used in the function
-	mockClient.On("SetBaseURL", mock.Anything).Return()
-
 	series, err := GetSeriesByID(mockClient, seriesID)
 
 	assert.NoError(t, err)
@@ -57,21 +71,82 @@ func TestGetSeriesByID(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+func TestGetSeriesByIDContext(t *testing.T) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "request")
+
+	mockClient := new(MockClient)
+	mockClient.On("GetContext", ctx, "/series/123", mock.Anything).Return(context.Canceled)
+
+	series, err := GetSeriesByIDContext(ctx, mockClient, 123)
+
+	assert.Nil(t, series)
+	assert.ErrorIs(t, err, context.Canceled)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetMovieByIDNotFound(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("GetContext", mock.Anything, "/movies/404", mock.Anything).
+		Return(&client.APIError{StatusCode: http.StatusNotFound, Method: "GET", Path: "/movies/404"})
+
+	movie, err := GetMovieByID(mockClient, 404)
+
+	assert.Nil(t, movie)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+	var apiErr *client.APIError
+	assert.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "/movies/404", apiErr.Path)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetSeriesEpisodes(t *testing.T) {
+	mockClient := new(MockClient)
+	expectedEpisodes := []models.Episode{{ID: 1, Name: "Pilot"}, {ID: 2, Name: "Second"}}
+
+	mockClient.On("GetContext", mock.Anything, "/series/123/episodes/default?page=0", mock.AnythingOfType("*models.Response[github.com/LaughinKuma/tvdb-go-api/models.SeriesEpisodes]")).
+		Run(func(args mock.Arguments) {
+			arg := args.Get(2).(*models.Response[models.SeriesEpisodes])
+			arg.Data.Episodes = expectedEpisodes
+			arg.Links.TotalItems = 2
+			arg.Links.PageSize = 500
+		}).
+		Return(nil)
+
+	episodes, total, pageSize, err := GetSeriesEpisodes(mockClient, 123, "default", 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedEpisodes, episodes)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, 500, pageSize)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetSeriesEpisodesError(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("GetContext", mock.Anything, "/series/123/episodes/default?page=0", mock.Anything).
+		Return(&client.APIError{StatusCode: http.StatusInternalServerError})
+
+	episodes, total, pageSize, err := GetSeriesEpisodes(mockClient, 123, "default", 0)
+
+	assert.ErrorIs(t, err, client.ErrServer)
+	assert.Nil(t, episodes)
+	assert.Zero(t, total)
+	assert.Zero(t, pageSize)
+}
+
 func TestGetSeriesSeasons(t *testing.T) {
 	mockClient := new(MockClient)
 	seriesID := 123
 	expectedSeasons := []models.Season{{ID: 1, Name: "Season 1"}, {ID: 2, Name: "Season 2"}}
 
-	mockClient.On("Get", "/series/123/seasons", mock.AnythingOfType("*struct { Data []models.Season }")).
+	mockClient.On("GetContext", mock.Anything, "/series/123/extended?short=true", mock.AnythingOfType("*models.Response[github.com/LaughinKuma/tvdb-go-api/models.SeriesExtended]")).
 		Run(func(args mock.Arguments) {
-			arg := args.Get(1).(*struct{ Data []models.Season })
-			arg.Data = expectedSeasons
+			arg := args.Get(2).(*models.Response[models.SeriesExtended])
+			arg.Data.Seasons = expectedSeasons
 		}).
 		Return(nil)
 
-	// Add expectation for SetBaseURL if it's used in the function
-	mockClient.On("SetBaseURL", mock.Anything).Return()
-
 	seasons, err := GetSeriesSeasons(mockClient, seriesID)
 
 	assert.NoError(t, err)
@@ -79,4 +154,799 @@ func TestGetSeriesSeasons(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
-// Add other test functions similarly, ensuring to include the SetBaseURL expectation if necessary
\ No newline at end of file
+// mockEpisodesPage sets up mockClient to return one page of series episodes.
+func mockEpisodesPage(mockClient *MockClient, page int, episodes []models.Episode, next string, total int) {
+	path := fmt.Sprintf("/series/123/episodes/default?page=%d", page)
+	mockClient.On("GetContext", mock.Anything, path, mock.Anything).
+		Run(func(args mock.Arguments) {
+			arg := args.Get(2).(*models.Response[models.SeriesEpisodes])
+			arg.Data.Episodes = episodes
+			arg.Links = models.Links{Next: next, TotalItems: total, PageSize: 2}
+		}).
+		Return(nil)
+}
+
+func mockThreeEpisodePages(mockClient *MockClient) {
+	next := "https://api4.thetvdb.com/v4/series/123/episodes/default?page=%d"
+	mockEpisodesPage(mockClient, 0, []models.Episode{{ID: 1}, {ID: 2}}, fmt.Sprintf(next, 1), 5)
+	mockEpisodesPage(mockClient, 1, []models.Episode{{ID: 3}, {ID: 4}}, fmt.Sprintf(next, 2), 5)
+	mockEpisodesPage(mockClient, 2, []models.Episode{{ID: 5}}, "", 5)
+}
+
+func TestSeriesEpisodesIterator(t *testing.T) {
+	mockClient := new(MockClient)
+	mockThreeEpisodePages(mockClient)
+
+	var ids []int
+	it := NewSeriesEpisodesIterator(context.Background(), mockClient, 123, "default")
+	for it.Next() {
+		ids = append(ids, it.Episode().ID)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+	mockClient.AssertExpectations(t)
+}
+
+func TestSeriesEpisodesIteratorEarlyTermination(t *testing.T) {
+	mockClient := new(MockClient)
+	mockThreeEpisodePages(mockClient)
+
+	it := NewSeriesEpisodesIterator(context.Background(), mockClient, 123, "default")
+	for it.Next() {
+		if it.Episode().ID == 2 {
+			break
+		}
+	}
+
+	assert.NoError(t, it.Err())
+	mockClient.AssertNumberOfCalls(t, "GetContext", 1)
+}
+
+func TestSeriesEpisodesIteratorError(t *testing.T) {
+	mockClient := new(MockClient)
+	mockEpisodesPage(mockClient, 0, []models.Episode{{ID: 1}}, "https://api4.thetvdb.com/v4/series/123/episodes/default?page=1", 2)
+	mockClient.On("GetContext", mock.Anything, "/series/123/episodes/default?page=1", mock.Anything).
+		Return(&client.APIError{StatusCode: http.StatusBadGateway})
+
+	var ids []int
+	it := NewSeriesEpisodesIterator(context.Background(), mockClient, 123, "default")
+	for it.Next() {
+		ids = append(ids, it.Episode().ID)
+	}
+
+	assert.Equal(t, []int{1}, ids)
+	assert.ErrorIs(t, it.Err(), client.ErrServer)
+	assert.False(t, it.Next())
+}
+
+func TestGetAllSeriesEpisodes(t *testing.T) {
+	for _, concurrency := range []int{1, 3} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			mockClient := new(MockClient)
+			mockThreeEpisodePages(mockClient)
+
+			episodes, err := GetAllSeriesEpisodes(mockClient, 123, "default", concurrency)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []models.Episode{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}}, episodes)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetAllSeriesEpisodesUnreliableTotal(t *testing.T) {
+	next := "https://api4.thetvdb.com/v4/series/123/episodes/default?page=%d"
+
+	tests := []struct {
+		name  string
+		total int
+	}{
+		{"missing total", 0},
+		{"total too low", 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockClient)
+			mockEpisodesPage(mockClient, 0, []models.Episode{{ID: 1}, {ID: 2}}, fmt.Sprintf(next, 1), tt.total)
+			mockEpisodesPage(mockClient, 1, []models.Episode{{ID: 3}, {ID: 4}}, fmt.Sprintf(next, 2), tt.total)
+			mockEpisodesPage(mockClient, 2, []models.Episode{{ID: 5}}, "", tt.total)
+
+			episodes, err := GetAllSeriesEpisodes(mockClient, 123, "default", 3)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []models.Episode{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}}, episodes)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetAllSeriesEpisodesConcurrentError(t *testing.T) {
+	mockClient := new(MockClient)
+	next := "https://api4.thetvdb.com/v4/series/123/episodes/default?page=1"
+	mockEpisodesPage(mockClient, 0, []models.Episode{{ID: 1}, {ID: 2}}, next, 4)
+	mockClient.On("GetContext", mock.Anything, "/series/123/episodes/default?page=1", mock.Anything).
+		Return(&client.APIError{StatusCode: http.StatusNotFound})
+
+	episodes, err := GetAllSeriesEpisodes(mockClient, 123, "default", 4)
+
+	assert.Nil(t, episodes)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestNextPage(t *testing.T) {
+	page, ok := nextPage(models.Links{Next: "https://api4.thetvdb.com/v4/series/1/episodes/default?page=3"})
+	assert.True(t, ok)
+	assert.Equal(t, 3, page)
+
+	_, ok = nextPage(models.Links{})
+	assert.False(t, ok)
+
+	_, ok = nextPage(models.Links{Next: "https://api4.thetvdb.com/v4/series/1/episodes/default"})
+	assert.False(t, ok)
+}
+
+func TestGetSeriesExtended(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *ExtendedOptions
+		path string
+	}{
+		{name: "Default", opts: nil, path: "/series/123/extended"},
+		{name: "Short", opts: &ExtendedOptions{Short: true}, path: "/series/123/extended?short=true"},
+		{name: "Episodes", opts: &ExtendedOptions{Meta: MetaEpisodes, Short: true}, path: "/series/123/extended?meta=episodes&short=true"},
+		{name: "Translations", opts: &ExtendedOptions{Meta: MetaTranslations}, path: "/series/123/extended?meta=translations"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockClient)
+			expected := models.SeriesExtended{
+				Series:     models.Series{ID: 123, Name: "Test Series"},
+				Characters: []models.Character{{ID: 1, Name: "Walter White"}},
+			}
+
+			mockClient.On("GetContext", mock.Anything, tt.path, mock.Anything).
+				Run(func(args mock.Arguments) {
+					args.Get(2).(*models.Response[models.SeriesExtended]).Data = expected
+				}).
+				Return(nil)
+
+			series, err := GetSeriesExtended(mockClient, 123, tt.opts)
+
+			assert.NoError(t, err)
+			assert.Equal(t, &expected, series)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetEpisodeExtended(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := models.EpisodeExtended{
+		Episode: models.Episode{ID: 456, Name: "Pilot"},
+		Characters: []models.Character{
+			{ID: 1, PersonName: "Vince Gilligan", PeopleType: "Writer"},
+			{ID: 2, PersonName: "Guest", PeopleType: "Guest Star"},
+		},
+	}
+
+	mockClient.On("GetContext", mock.Anything, "/episodes/456/extended?meta=translations", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.EpisodeExtended]).Data = expected
+		}).
+		Return(nil)
+
+	episode, err := GetEpisodeExtended(mockClient, 456, &ExtendedOptions{Meta: MetaTranslations})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, episode)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetMovieExtended(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := models.MovieExtended{
+		Movie:     models.Movie{ID: 789, Name: "Test Movie"},
+		BoxOffice: "1000000",
+		Releases:  []models.Release{{Country: "usa", Date: "2019-10-04"}},
+	}
+
+	mockClient.On("GetContext", mock.Anything, "/movies/789/extended?meta=translations&short=true", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.MovieExtended]).Data = expected
+		}).
+		Return(nil)
+
+	movie, err := GetMovieExtended(mockClient, 789, &ExtendedOptions{Meta: MetaTranslations, Short: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, movie)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetSeasonByID(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := models.Season{ID: 10, Number: 1, Type: models.SeasonType{ID: 1, Type: models.SeasonTypeAired}}
+
+	mockClient.On("GetContext", mock.Anything, "/seasons/10", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.Season]).Data = expected
+		}).
+		Return(nil)
+
+	season, err := GetSeasonByID(mockClient, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, season)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetSeasonExtended(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := models.SeasonExtended{
+		Season:   models.Season{ID: 10},
+		Episodes: []models.Episode{{ID: 1}, {ID: 2}},
+		Trailers: []models.Trailer{{ID: 3}},
+	}
+
+	mockClient.On("GetContext", mock.Anything, "/seasons/10/extended", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.SeasonExtended]).Data = expected
+		}).
+		Return(nil)
+
+	season, err := GetSeasonExtended(mockClient, 10, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, season)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetSeasonTranslation(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := models.Translation{Language: "deu", Name: "Staffel 1"}
+
+	mockClient.On("GetContext", mock.Anything, "/seasons/10/translations/deu", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.Translation]).Data = expected
+		}).
+		Return(nil)
+
+	translation, err := GetSeasonTranslation(mockClient, 10, "deu")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, translation)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetSeasonTypes(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := []models.SeasonType{
+		{ID: 1, Name: "Aired Order", Type: models.SeasonTypeAired},
+		{ID: 2, Name: "DVD Order", Type: models.SeasonTypeDVD},
+	}
+
+	mockClient.On("GetContext", mock.Anything, "/seasons/types", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[[]models.SeasonType]).Data = expected
+		}).
+		Return(nil)
+
+	types, err := GetSeasonTypes(mockClient)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, types)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetPersonByID(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := models.Person{ID: 17, Name: "Bryan Cranston"}
+
+	mockClient.On("GetContext", mock.Anything, "/people/17", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.Person]).Data = expected
+		}).
+		Return(nil)
+
+	person, err := GetPersonByID(mockClient, 17)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, person)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetPersonExtended(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := models.PersonExtended{
+		Person:      models.Person{ID: 17, Name: "Bryan Cranston"},
+		Biographies: []models.Biography{{Language: "eng", Biography: "Actor"}},
+		Characters:  []models.Character{{ID: 1, Name: "Walter White"}},
+	}
+
+	mockClient.On("GetContext", mock.Anything, "/people/17/extended?meta=translations", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.PersonExtended]).Data = expected
+		}).
+		Return(nil)
+
+	person, err := GetPersonExtended(mockClient, 17, &ExtendedOptions{Meta: MetaTranslations})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, person)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetPersonTranslation(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("GetContext", mock.Anything, "/people/17/translations/fra", mock.Anything).
+		Return(&client.APIError{StatusCode: http.StatusNotFound})
+
+	translation, err := GetPersonTranslation(mockClient, 17, "fra")
+
+	assert.Nil(t, translation)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetPeopleTypes(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := []models.PeopleType{{ID: 1, Name: "Director"}, {ID: 3, Name: "Actor"}}
+
+	mockClient.On("GetContext", mock.Anything, "/people/types", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[[]models.PeopleType]).Data = expected
+		}).
+		Return(nil)
+
+	types, err := GetPeopleTypes(mockClient)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, types)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetCharacterByID(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := models.Character{ID: 1, Name: "Walter White", PeopleID: 17, SeriesID: 81189, PersonName: "Bryan Cranston"}
+
+	mockClient.On("GetContext", mock.Anything, "/characters/1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.Character]).Data = expected
+		}).
+		Return(nil)
+
+	character, err := GetCharacterByID(mockClient, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, character)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetArtworkExtended(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := models.ArtworkExtended{
+		Artwork:  models.Artwork{ID: 5, Type: models.ArtworkTypeSeriesPoster, URL: "https://example.com/poster.jpg"},
+		SeriesID: 81189,
+		Status:   models.ArtworkStatus{ID: 1, Name: "Approved"},
+	}
+
+	mockClient.On("GetContext", mock.Anything, "/artwork/5/extended", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.ArtworkExtended]).Data = expected
+		}).
+		Return(nil)
+
+	artwork, err := GetArtworkExtended(mockClient, 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, artwork)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetSeriesArtworks(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *ArtworkOptions
+		path string
+	}{
+		{"no filters", nil, "/series/81189/artworks"},
+		{"empty filters", &ArtworkOptions{}, "/series/81189/artworks"},
+		{"language and type", &ArtworkOptions{Language: "eng", Type: models.ArtworkTypeSeriesPoster}, "/series/81189/artworks?lang=eng&type=2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockClient)
+			expected := []models.Artwork{{ID: 5, Type: models.ArtworkTypeSeriesPoster, Language: "eng"}}
+
+			mockClient.On("GetContext", mock.Anything, tt.path, mock.Anything).
+				Run(func(args mock.Arguments) {
+					args.Get(2).(*models.Response[models.SeriesExtended]).Data.Artworks = expected
+				}).
+				Return(nil)
+
+			artworks, err := GetSeriesArtworks(mockClient, 81189, tt.opts)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, artworks)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetArtworkTypes(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := []models.ArtworkType{{ID: 2, Name: "Poster", RecordType: "series", Width: 680, Height: 1000}}
+
+	mockClient.On("GetContext", mock.Anything, "/artwork/types", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[[]models.ArtworkType]).Data = expected
+		}).
+		Return(nil)
+
+	types, err := GetArtworkTypes(mockClient)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, types)
+	mockClient.AssertExpectations(t)
+}
+
+// mockUpdatesPage sets up mockClient to return one page of the updates feed.
+func mockUpdatesPage(mockClient *MockClient, path string, updates []models.EntityUpdate, next string) {
+	mockClient.On("GetContext", mock.Anything, path, mock.Anything).
+		Run(func(args mock.Arguments) {
+			arg := args.Get(2).(*models.Response[[]models.EntityUpdate])
+			arg.Data = updates
+			arg.Links = models.Links{Next: next}
+		}).
+		Return(nil)
+}
+
+func TestGetUpdates(t *testing.T) {
+	since := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name     string
+		opts     *UpdatesOptions
+		path     string
+		expected []int
+	}{
+		{"no filters", nil, "/updates?page=%d&since=1700000000", []int{1, 2, 3}},
+		{"type and action", &UpdatesOptions{Type: models.UpdateEntitySeries, Action: models.UpdateMethodDelete}, "/updates?action=delete&page=%d&since=1700000000&type=series", []int{1, 2, 3}},
+		{"creations are filtered locally", &UpdatesOptions{Action: models.UpdateMethodCreate}, "/updates?page=%d&since=1700000000", []int{1, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockClient)
+			mockUpdatesPage(mockClient, fmt.Sprintf(tt.path, 0), []models.EntityUpdate{
+				{RecordID: 1, Method: models.UpdateMethodCreate},
+				{RecordID: 2, Method: models.UpdateMethodUpdate},
+			}, "https://api4.thetvdb.com/v4"+fmt.Sprintf(tt.path, 1))
+			mockUpdatesPage(mockClient, fmt.Sprintf(tt.path, 1), []models.EntityUpdate{
+				{RecordID: 3, Method: models.UpdateMethodCreate},
+			}, "")
+
+			updates, err := GetUpdates(mockClient, since, tt.opts)
+
+			assert.NoError(t, err)
+			var ids []int
+			for _, u := range updates {
+				ids = append(ids, u.RecordID)
+			}
+			assert.Equal(t, tt.expected, ids)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdatesIteratorError(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("GetContext", mock.Anything, "/updates?page=0&since=1700000000", mock.Anything).
+		Return(&client.APIError{StatusCode: http.StatusInternalServerError})
+
+	it := NewUpdatesIterator(context.Background(), mockClient, time.Unix(1700000000, 0), nil)
+
+	assert.False(t, it.Next())
+	assert.ErrorIs(t, it.Err(), client.ErrServer)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetTranslation(t *testing.T) {
+	tests := []struct {
+		name string
+		get  func(c client.ClientInterface) (*models.Translation, error)
+		path string
+	}{
+		{"series", func(c client.ClientInterface) (*models.Translation, error) { return GetSeriesTranslation(c, 1, "deu") }, "/series/1/translations/deu"},
+		{"episode", func(c client.ClientInterface) (*models.Translation, error) { return GetEpisodeTranslation(c, 1, "deu") }, "/episodes/1/translations/deu"},
+		{"movie", func(c client.ClientInterface) (*models.Translation, error) { return GetMovieTranslation(c, 1, "deu") }, "/movies/1/translations/deu"},
+		{"generic", func(c client.ClientInterface) (*models.Translation, error) {
+			return GetTranslation(c, TranslationPeople, 1, "deu")
+		}, "/people/1/translations/deu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockClient)
+			expected := models.Translation{Language: "deu", Name: "Name", Overview: "Übersicht", IsPrimary: true}
+
+			mockClient.On("GetContext", mock.Anything, tt.path, mock.Anything).
+				Run(func(args mock.Arguments) {
+					args.Get(2).(*models.Response[models.Translation]).Data = expected
+				}).
+				Return(nil)
+
+			translation, err := tt.get(mockClient)
+
+			assert.NoError(t, err)
+			assert.Equal(t, &expected, translation)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetTranslationWithFallback(t *testing.T) {
+	notFound := &client.APIError{StatusCode: http.StatusNotFound}
+
+	t.Run("first available", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expected := models.Translation{Language: "eng", Name: "Breaking Bad"}
+
+		mockClient.On("GetContext", mock.Anything, "/series/81189/translations/deu", mock.Anything).Return(notFound)
+		mockClient.On("GetContext", mock.Anything, "/series/81189/translations/eng", mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(2).(*models.Response[models.Translation]).Data = expected
+			}).
+			Return(nil)
+
+		translation, err := GetTranslationWithFallback(mockClient, TranslationSeries, 81189, []string{"deu", "eng", "fra"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &expected, translation)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("none available", func(t *testing.T) {
+		mockClient := new(MockClient)
+		mockClient.On("GetContext", mock.Anything, mock.Anything, mock.Anything).Return(notFound)
+
+		translation, err := GetTranslationWithFallback(mockClient, TranslationSeries, 81189, []string{"deu", "eng"})
+
+		assert.Nil(t, translation)
+		assert.ErrorIs(t, err, client.ErrNotFound)
+		mockClient.AssertNumberOfCalls(t, "GetContext", 2)
+	})
+
+	t.Run("other errors stop the chain", func(t *testing.T) {
+		mockClient := new(MockClient)
+		mockClient.On("GetContext", mock.Anything, "/series/81189/translations/deu", mock.Anything).
+			Return(&client.APIError{StatusCode: http.StatusServiceUnavailable})
+
+		translation, err := GetTranslationWithFallback(mockClient, TranslationSeries, 81189, []string{"deu", "eng"})
+
+		assert.Nil(t, translation)
+		assert.ErrorIs(t, err, client.ErrServer)
+		mockClient.AssertExpectations(t)
+	})
+}
+
+func TestGetSeriesByIDLocalized(t *testing.T) {
+	mockClient := new(MockClient)
+	ctx := client.ContextWithLanguages(context.Background(), "deu", "eng")
+
+	mockClient.On("GetContext", mock.Anything, "/series/1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.Series]).Data = models.Series{
+				ID:                   1,
+				Name:                 "Original",
+				Overview:             "Original overview",
+				NameTranslations:     []string{"eng", "fra"},
+				OverviewTranslations: []string{"deu", "eng"},
+			}
+		}).
+		Return(nil)
+	mockClient.On("GetContext", mock.Anything, "/series/1/translations/eng", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.Translation]).Data = models.Translation{Language: "eng", Name: "English name"}
+		}).
+		Return(nil)
+	mockClient.On("GetContext", mock.Anything, "/series/1/translations/deu", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.Translation]).Data = models.Translation{Language: "deu", Overview: "Deutsche Übersicht"}
+		}).
+		Return(nil)
+
+	series, err := GetSeriesByIDContext(ctx, mockClient, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "English name", series.Name)
+	assert.Equal(t, "Deutsche Übersicht", series.Overview)
+	assert.Equal(t, models.Localization{Name: "eng", Overview: "deu"}, series.Localization)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetEpisodeByIDLocalizationFailure(t *testing.T) {
+	episode := models.Episode{
+		ID:                   456,
+		Name:                 "Pilot",
+		Overview:             "Original overview",
+		NameTranslations:     []string{"deu"},
+		OverviewTranslations: []string{"deu"},
+	}
+
+	t.Run("translation not found", func(t *testing.T) {
+		mockClient := new(MockClient)
+		ctx := client.ContextWithLanguages(context.Background(), "deu")
+
+		mockClient.On("GetContext", mock.Anything, "/episodes/456", mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(2).(*models.Response[models.Episode]).Data = episode
+			}).
+			Return(nil)
+		mockClient.On("GetContext", mock.Anything, "/episodes/456/translations/deu", mock.Anything).
+			Return(&client.APIError{StatusCode: http.StatusNotFound}).Once()
+
+		got, err := GetEpisodeByIDContext(ctx, mockClient, 456)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Pilot", got.Name)
+		assert.Equal(t, "Original overview", got.Overview)
+		assert.Equal(t, models.Localization{}, got.Localization)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		mockClient := new(MockClient)
+		ctx, cancel := context.WithCancel(client.ContextWithLanguages(context.Background(), "deu"))
+
+		mockClient.On("GetContext", mock.Anything, "/episodes/456", mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(2).(*models.Response[models.Episode]).Data = episode
+			}).
+			Return(nil)
+		mockClient.On("GetContext", mock.Anything, "/episodes/456/translations/deu", mock.Anything).
+			Run(func(args mock.Arguments) { cancel() }).
+			Return(context.Canceled)
+
+		got, err := GetEpisodeByIDContext(ctx, mockClient, 456)
+
+		assert.Nil(t, got)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestGetMovieExtendedLocalizedFromIncludedTranslations(t *testing.T) {
+	mockClient := new(MockClient)
+	ctx := client.ContextWithLanguages(context.Background(), "deu")
+
+	mockClient.On("GetContext", mock.Anything, "/movies/789/extended?meta=translations", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.MovieExtended]).Data = models.MovieExtended{
+				Movie: models.Movie{
+					ID:                   789,
+					Name:                 "The Movie",
+					NameTranslations:     []string{"deu", "eng"},
+					OverviewTranslations: []string{"eng"},
+				},
+				Translations: &models.Translations{
+					NameTranslations: []models.Translation{{Language: "deu", Name: "Der Film"}},
+				},
+			}
+		}).
+		Return(nil)
+
+	movie, err := GetMovieExtendedContext(ctx, mockClient, 789, &ExtendedOptions{Meta: MetaTranslations})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Der Film", movie.Name)
+	assert.Equal(t, models.Localization{Name: "deu"}, movie.Localization)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetSeriesEpisodesLocalized(t *testing.T) {
+	mockClient := new(MockClient)
+	ctx := client.ContextWithLanguages(context.Background(), "deu", "eng")
+
+	mockClient.On("GetContext", mock.Anything, "/series/123/episodes/default/deu?page=0", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.SeriesEpisodes]).Data.Episodes = []models.Episode{
+				{ID: 1, Name: "Pilot", Overview: "Overview", NameTranslations: []string{"eng", "deu"}},
+				// Not translated to German, so the name is in the original language
+				{ID: 2, Name: "Original", NameTranslations: []string{"eng"}},
+			}
+		}).
+		Return(nil)
+
+	episodes, _, _, err := GetSeriesEpisodesContext(ctx, mockClient, 123, "default", 0)
+
+	assert.NoError(t, err)
+	require.Len(t, episodes, 2)
+	assert.Equal(t, models.Localization{Name: "deu"}, episodes[0].Localization)
+	assert.Equal(t, models.Localization{}, episodes[1].Localization)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetSeriesBySlug(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := models.Series{ID: 81189, Name: "Breaking Bad", Slug: "breaking-bad"}
+
+	mockClient.On("GetContext", mock.Anything, "/series/slug/breaking-bad", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[models.Series]).Data = expected
+		}).
+		Return(nil)
+
+	series, err := GetSeriesBySlug(mockClient, "breaking-bad")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, series)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetMovieBySlugNotFound(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("GetContext", mock.Anything, "/movies/slug/no%2Fsuch-movie", mock.Anything).
+		Return(&client.APIError{StatusCode: http.StatusNotFound})
+
+	movie, err := GetMovieBySlug(mockClient, "no/such-movie")
+
+	assert.Nil(t, movie)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetSourceTypes(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := []models.SourceType{{ID: models.SourceTypeIMDB, Name: "IMDB", Slug: "imdb"}}
+
+	mockClient.On("GetContext", mock.Anything, "/sources/types", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.Response[[]models.SourceType]).Data = expected
+		}).
+		Return(nil)
+
+	types, err := GetSourceTypes(mockClient)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, types)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetSeriesByIDLoginError(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{"invalid API key", http.StatusUnauthorized, client.ErrUnauthorized},
+		{"login throttled", http.StatusTooManyRequests, client.ErrRateLimited},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/login", r.URL.Path)
+				w.WriteHeader(tt.status)
+				w.Write([]byte(`{"status": "failure", "message": "login rejected"}`))
+			}))
+			defer ts.Close()
+
+			c, err := client.NewClient("bad-key", client.WithBaseURL(ts.URL), client.WithLazyAuth(), client.WithRetryMax(0))
+			require.NoError(t, err)
+
+			series, err := GetSeriesByID(c, 81189)
+
+			assert.Nil(t, series)
+			assert.ErrorIs(t, err, tt.wantErr)
+			var apiErr *client.APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, "login rejected", apiErr.Message)
+			assert.Equal(t, "/login", apiErr.Path)
+		})
+	}
+}
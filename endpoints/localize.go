@@ -0,0 +1,142 @@
+package endpoints
+
+import (
+	"context"
+
+	"github.com/LaughinKuma/tvdb-go-api/client"
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// languagePreferrer is implemented by clients with default languages, such
+// as *client.Client.
+type languagePreferrer interface {
+	Languages(ctx context.Context) []string
+}
+
+// preferredLanguages returns the languages requests made with ctx should be
+// localized to, most preferred first.
+func preferredLanguages(ctx context.Context, c client.ClientInterface) []string {
+	if lp, ok := c.(languagePreferrer); ok {
+		return lp.Languages(ctx)
+	}
+	languages, _ := client.LanguagesFromContext(ctx)
+	return languages
+}
+
+// localizable points to the localizable fields of a record.
+type localizable struct {
+	name              *string
+	overview          *string
+	localization      *models.Localization
+	nameLanguages     []string
+	overviewLanguages []string
+	// included holds the translations returned with meta=translations, if any.
+	included *models.Translations
+}
+
+// localize replaces the name and overview with their translation in the
+// most preferred language that has one, recording the language used.
+// Localization is best effort: a field whose translation cannot be fetched
+// keeps its original text, and only a cancelled context is reported.
+func localize(ctx context.Context, c client.ClientInterface, entity TranslationEntity, id int, l localizable) error {
+	languages := preferredLanguages(ctx, c)
+	if len(languages) == 0 {
+		return nil
+	}
+
+	fetched := make(map[string]*models.Translation)
+	translation := func(language string) *models.Translation {
+		if t, ok := fetched[language]; ok {
+			return t
+		}
+		t, err := getTranslation(ctx, c, entity, id, language)
+		if err != nil {
+			// The field keeps its original text
+			t = nil
+		}
+		fetched[language] = t
+		return t
+	}
+
+	if language := pickLanguage(languages, l.nameLanguages); language != "" {
+		name := ""
+		if l.included != nil {
+			name = findTranslation(l.included.NameTranslations, language).Name
+		} else if t := translation(language); t != nil {
+			name = t.Name
+		}
+		if name != "" {
+			*l.name = name
+			l.localization.Name = language
+		}
+	}
+
+	if language := pickLanguage(languages, l.overviewLanguages); language != "" {
+		overview := ""
+		if l.included != nil {
+			overview = findTranslation(l.included.OverviewTranslations, language).Overview
+		} else if t := translation(language); t != nil {
+			overview = t.Overview
+		}
+		if overview != "" {
+			*l.overview = overview
+			l.localization.Overview = language
+		}
+	}
+
+	return ctx.Err()
+}
+
+// pickLanguage returns the first preferred language that is available.
+func pickLanguage(preferred, available []string) string {
+	for _, p := range preferred {
+		for _, a := range available {
+			if p == a {
+				return p
+			}
+		}
+	}
+	return ""
+}
+
+func findTranslation(translations []models.Translation, language string) models.Translation {
+	for _, t := range translations {
+		if t.Language == language {
+			return t
+		}
+	}
+	return models.Translation{}
+}
+
+func localizeSeries(ctx context.Context, c client.ClientInterface, s *models.Series, included *models.Translations) error {
+	return localize(ctx, c, TranslationSeries, s.ID, localizable{
+		name:              &s.Name,
+		overview:          &s.Overview,
+		localization:      &s.Localization,
+		nameLanguages:     s.NameTranslations,
+		overviewLanguages: s.OverviewTranslations,
+		included:          included,
+	})
+}
+
+func localizeEpisode(ctx context.Context, c client.ClientInterface, e *models.Episode, included *models.Translations) error {
+	return localize(ctx, c, TranslationEpisodes, e.ID, localizable{
+		name:              &e.Name,
+		overview:          &e.Overview,
+		localization:      &e.Localization,
+		nameLanguages:     e.NameTranslations,
+		overviewLanguages: e.OverviewTranslations,
+		included:          included,
+	})
+}
+
+func localizeMovie(ctx context.Context, c client.ClientInterface, m *models.Movie, included *models.Translations) error {
+	return localize(ctx, c, TranslationMovies, m.ID, localizable{
+		name:              &m.Name,
+		overview:          &m.Overview,
+		localization:      &m.Localization,
+		nameLanguages:     m.NameTranslations,
+		overviewLanguages: m.OverviewTranslations,
+		included:          included,
+	})
+}
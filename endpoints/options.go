@@ -0,0 +1,85 @@
+package endpoints
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// Meta selects additional data to include in extended responses.
+type Meta string
+
+const (
+	// MetaTranslations includes all translations of the record.
+	MetaTranslations Meta = "translations"
+	// MetaEpisodes includes the episodes of a series.
+	MetaEpisodes Meta = "episodes"
+)
+
+// ExtendedOptions configures requests to extended endpoints. A nil
+// *ExtendedOptions requests the default response.
+type ExtendedOptions struct {
+	// Meta requests additional data in the response.
+	Meta Meta
+	// Short omits characters, artworks and trailers from the response.
+	Short bool
+}
+
+// query returns the query string for the options, including the leading "?".
+func (o *ExtendedOptions) query() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Meta != "" {
+		v.Set("meta", string(o.Meta))
+	}
+	if o.Short {
+		v.Set("short", "true")
+	}
+
+	if len(v) == 0 {
+		return ""
+	}
+	return "?" + v.Encode()
+}
+
+// ArtworkOptions filters the artworks returned by GetSeriesArtworks.
+type ArtworkOptions struct {
+	// Language is a three-letter language code, e.g. "eng".
+	Language string
+	// Type is an artwork type ID, e.g. models.ArtworkTypeSeriesPoster.
+	Type int
+}
+
+// query returns the query string for the options, including the leading "?".
+func (o *ArtworkOptions) query() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Language != "" {
+		v.Set("lang", o.Language)
+	}
+	if o.Type != 0 {
+		v.Set("type", strconv.Itoa(o.Type))
+	}
+
+	if len(v) == 0 {
+		return ""
+	}
+	return "?" + v.Encode()
+}
+
+// UpdatesOptions filters the updates feed. A nil *UpdatesOptions returns
+// updates of every entity type and method.
+type UpdatesOptions struct {
+	// Type restricts the feed to one entity type, e.g. models.UpdateEntitySeries.
+	Type models.UpdateEntityType
+	// Action restricts the feed to one update method. The API only filters
+	// updates and deletions, so creations are filtered locally.
+	Action models.UpdateMethod
+}
@@ -0,0 +1,79 @@
+package endpoints
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// pageFunc fetches one page of a list endpoint.
+type pageFunc[T any] func(ctx context.Context, page int) ([]T, models.Links, error)
+
+// pager walks the pages of a list endpoint by following links.next.
+type pager[T any] struct {
+	ctx     context.Context
+	fetch   pageFunc[T]
+	page    int
+	done    bool
+	items   []T
+	index   int
+	current T
+	err     error
+}
+
+func newPager[T any](ctx context.Context, fetch pageFunc[T]) *pager[T] {
+	return &pager[T]{ctx: ctx, fetch: fetch}
+}
+
+// next advances to the next item, fetching the next page when needed.
+func (p *pager[T]) next() bool {
+	for p.index >= len(p.items) {
+		if p.done || p.err != nil {
+			return false
+		}
+
+		items, links, err := p.fetch(p.ctx, p.page)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.items, p.index = items, 0
+
+		next, ok := nextPage(links)
+		if !ok || next <= p.page {
+			p.done = true
+		}
+		p.page = next
+	}
+
+	p.current = p.items[p.index]
+	p.index++
+	return true
+}
+
+// nextPage extracts the page number from links.next. It reports false when
+// there is no next page.
+func nextPage(links models.Links) (int, bool) {
+	if links.Next == "" {
+		return 0, false
+	}
+	u, err := url.Parse(links.Next)
+	if err != nil {
+		return 0, false
+	}
+	page, err := strconv.Atoi(u.Query().Get("page"))
+	if err != nil {
+		return 0, false
+	}
+	return page, true
+}
+
+// pageCount returns the number of pages needed to hold all items described by links.
+func pageCount(links models.Links) int {
+	if links.PageSize <= 0 {
+		return 1
+	}
+	return (links.TotalItems + links.PageSize - 1) / links.PageSize
+}
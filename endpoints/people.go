@@ -0,0 +1,75 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LaughinKuma/tvdb-go-api/client"
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// GetPersonByID fetches a person by their ID.
+func GetPersonByID(c client.ClientInterface, id int) (*models.Person, error) {
+	return GetPersonByIDContext(context.Background(), c, id)
+}
+
+// GetPersonByIDContext is like GetPersonByID but uses the provided context.
+func GetPersonByIDContext(ctx context.Context, c client.ClientInterface, id int) (*models.Person, error) {
+	path := fmt.Sprintf("/people/%d", id)
+
+	response, err := client.GetResponse[models.Person](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get person: %w", err)
+	}
+
+	return &response.Data, nil
+}
+
+// GetPersonExtended fetches a person with their biographies, the characters
+// they played across series and movies, awards, remote IDs and aliases.
+func GetPersonExtended(c client.ClientInterface, id int, opts *ExtendedOptions) (*models.PersonExtended, error) {
+	return GetPersonExtendedContext(context.Background(), c, id, opts)
+}
+
+// GetPersonExtendedContext is like GetPersonExtended but uses the provided context.
+func GetPersonExtendedContext(ctx context.Context, c client.ClientInterface, id int, opts *ExtendedOptions) (*models.PersonExtended, error) {
+	path := fmt.Sprintf("/people/%d/extended%s", id, opts.query())
+
+	response, err := client.GetResponse[models.PersonExtended](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get extended person: %w", err)
+	}
+
+	return &response.Data, nil
+}
+
+// GetPersonTranslation fetches the translation of a person in the given
+// three-letter language code, e.g. "eng".
+func GetPersonTranslation(c client.ClientInterface, id int, language string) (*models.Translation, error) {
+	return GetPersonTranslationContext(context.Background(), c, id, language)
+}
+
+// GetPersonTranslationContext is like GetPersonTranslation but uses the provided context.
+func GetPersonTranslationContext(ctx context.Context, c client.ClientInterface, id int, language string) (*models.Translation, error) {
+	translation, err := getTranslation(ctx, c, TranslationPeople, id, language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get person translation: %w", err)
+	}
+
+	return translation, nil
+}
+
+// GetPeopleTypes fetches the list of roles a person can have.
+func GetPeopleTypes(c client.ClientInterface) ([]models.PeopleType, error) {
+	return GetPeopleTypesContext(context.Background(), c)
+}
+
+// GetPeopleTypesContext is like GetPeopleTypes but uses the provided context.
+func GetPeopleTypesContext(ctx context.Context, c client.ClientInterface) ([]models.PeopleType, error) {
+	response, err := client.GetResponse[[]models.PeopleType](ctx, c, "/people/types")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get people types: %w", err)
+	}
+
+	return response.Data, nil
+}
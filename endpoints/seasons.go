@@ -0,0 +1,74 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LaughinKuma/tvdb-go-api/client"
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// GetSeasonByID fetches a season by its ID.
+func GetSeasonByID(c client.ClientInterface, id int) (*models.Season, error) {
+	return GetSeasonByIDContext(context.Background(), c, id)
+}
+
+// GetSeasonByIDContext is like GetSeasonByID but uses the provided context.
+func GetSeasonByIDContext(ctx context.Context, c client.ClientInterface, id int) (*models.Season, error) {
+	path := fmt.Sprintf("/seasons/%d", id)
+
+	response, err := client.GetResponse[models.Season](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season: %w", err)
+	}
+
+	return &response.Data, nil
+}
+
+// GetSeasonExtended fetches a season with its episodes, artwork and trailers.
+func GetSeasonExtended(c client.ClientInterface, id int, opts *ExtendedOptions) (*models.SeasonExtended, error) {
+	return GetSeasonExtendedContext(context.Background(), c, id, opts)
+}
+
+// GetSeasonExtendedContext is like GetSeasonExtended but uses the provided context.
+func GetSeasonExtendedContext(ctx context.Context, c client.ClientInterface, id int, opts *ExtendedOptions) (*models.SeasonExtended, error) {
+	path := fmt.Sprintf("/seasons/%d/extended%s", id, opts.query())
+
+	response, err := client.GetResponse[models.SeasonExtended](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get extended season: %w", err)
+	}
+
+	return &response.Data, nil
+}
+
+// GetSeasonTranslation fetches the translation of a season in the given
+// three-letter language code, e.g. "eng".
+func GetSeasonTranslation(c client.ClientInterface, id int, language string) (*models.Translation, error) {
+	return GetSeasonTranslationContext(context.Background(), c, id, language)
+}
+
+// GetSeasonTranslationContext is like GetSeasonTranslation but uses the provided context.
+func GetSeasonTranslationContext(ctx context.Context, c client.ClientInterface, id int, language string) (*models.Translation, error) {
+	translation, err := getTranslation(ctx, c, TranslationSeasons, id, language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season translation: %w", err)
+	}
+
+	return translation, nil
+}
+
+// GetSeasonTypes fetches the list of episode orderings supported by TVDB.
+func GetSeasonTypes(c client.ClientInterface) ([]models.SeasonType, error) {
+	return GetSeasonTypesContext(context.Background(), c)
+}
+
+// GetSeasonTypesContext is like GetSeasonTypes but uses the provided context.
+func GetSeasonTypesContext(ctx context.Context, c client.ClientInterface) ([]models.SeasonType, error) {
+	response, err := client.GetResponse[[]models.SeasonType](ctx, c, "/seasons/types")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season types: %w", err)
+	}
+
+	return response.Data, nil
+}
@@ -0,0 +1,25 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LaughinKuma/tvdb-go-api/client"
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// GetSourceTypes fetches the catalogue of external databases that remote
+// IDs refer to.
+func GetSourceTypes(c client.ClientInterface) ([]models.SourceType, error) {
+	return GetSourceTypesContext(context.Background(), c)
+}
+
+// GetSourceTypesContext is like GetSourceTypes but uses the provided context.
+func GetSourceTypesContext(ctx context.Context, c client.ClientInterface) ([]models.SourceType, error) {
+	response, err := client.GetResponse[[]models.SourceType](ctx, c, "/sources/types")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source types: %w", err)
+	}
+
+	return response.Data, nil
+}
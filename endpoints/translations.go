@@ -0,0 +1,122 @@
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/LaughinKuma/tvdb-go-api/client"
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// TranslationEntity is a type of record that has translations.
+type TranslationEntity string
+
+// Record types with translations
+const (
+	TranslationSeries   TranslationEntity = "series"
+	TranslationSeasons  TranslationEntity = "seasons"
+	TranslationEpisodes TranslationEntity = "episodes"
+	TranslationMovies   TranslationEntity = "movies"
+	TranslationPeople   TranslationEntity = "people"
+)
+
+// GetTranslation fetches the translation of a record in the given
+// three-letter language code, e.g. "eng".
+func GetTranslation(c client.ClientInterface, entity TranslationEntity, id int, language string) (*models.Translation, error) {
+	return GetTranslationContext(context.Background(), c, entity, id, language)
+}
+
+// GetTranslationContext is like GetTranslation but uses the provided context.
+func GetTranslationContext(ctx context.Context, c client.ClientInterface, entity TranslationEntity, id int, language string) (*models.Translation, error) {
+	translation, err := getTranslation(ctx, c, entity, id, language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get translation: %w", err)
+	}
+
+	return translation, nil
+}
+
+// GetTranslationWithFallback tries each language in order and returns the
+// first translation that exists, e.g. []string{"deu", "eng"} falls back to
+// English when there is no German translation. If none exists the error
+// matches client.ErrNotFound.
+func GetTranslationWithFallback(c client.ClientInterface, entity TranslationEntity, id int, languages []string) (*models.Translation, error) {
+	return GetTranslationWithFallbackContext(context.Background(), c, entity, id, languages)
+}
+
+// GetTranslationWithFallbackContext is like GetTranslationWithFallback but uses the provided context.
+func GetTranslationWithFallbackContext(ctx context.Context, c client.ClientInterface, entity TranslationEntity, id int, languages []string) (*models.Translation, error) {
+	for _, language := range languages {
+		translation, err := getTranslation(ctx, c, entity, id, language)
+		if err == nil {
+			return translation, nil
+		}
+		if !errors.Is(err, client.ErrNotFound) {
+			return nil, fmt.Errorf("failed to get translation: %w", err)
+		}
+	}
+
+	return nil, fmt.Errorf("failed to get translation: none of %s available: %w", strings.Join(languages, ", "), client.ErrNotFound)
+}
+
+// GetSeriesTranslation fetches the translation of a series in the given
+// three-letter language code, e.g. "eng".
+func GetSeriesTranslation(c client.ClientInterface, id int, language string) (*models.Translation, error) {
+	return GetSeriesTranslationContext(context.Background(), c, id, language)
+}
+
+// GetSeriesTranslationContext is like GetSeriesTranslation but uses the provided context.
+func GetSeriesTranslationContext(ctx context.Context, c client.ClientInterface, id int, language string) (*models.Translation, error) {
+	translation, err := getTranslation(ctx, c, TranslationSeries, id, language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get series translation: %w", err)
+	}
+
+	return translation, nil
+}
+
+// GetEpisodeTranslation fetches the translation of an episode in the given
+// three-letter language code, e.g. "eng".
+func GetEpisodeTranslation(c client.ClientInterface, id int, language string) (*models.Translation, error) {
+	return GetEpisodeTranslationContext(context.Background(), c, id, language)
+}
+
+// GetEpisodeTranslationContext is like GetEpisodeTranslation but uses the provided context.
+func GetEpisodeTranslationContext(ctx context.Context, c client.ClientInterface, id int, language string) (*models.Translation, error) {
+	translation, err := getTranslation(ctx, c, TranslationEpisodes, id, language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get episode translation: %w", err)
+	}
+
+	return translation, nil
+}
+
+// GetMovieTranslation fetches the translation of a movie in the given
+// three-letter language code, e.g. "eng".
+func GetMovieTranslation(c client.ClientInterface, id int, language string) (*models.Translation, error) {
+	return GetMovieTranslationContext(context.Background(), c, id, language)
+}
+
+// GetMovieTranslationContext is like GetMovieTranslation but uses the provided context.
+func GetMovieTranslationContext(ctx context.Context, c client.ClientInterface, id int, language string) (*models.Translation, error) {
+	translation, err := getTranslation(ctx, c, TranslationMovies, id, language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get movie translation: %w", err)
+	}
+
+	return translation, nil
+}
+
+func getTranslation(ctx context.Context, c client.ClientInterface, entity TranslationEntity, id int, language string) (*models.Translation, error) {
+	path := fmt.Sprintf("/%s/%d/translations/%s", entity, id, url.PathEscape(language))
+
+	response, err := client.GetResponse[models.Translation](ctx, c, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Data, nil
+}
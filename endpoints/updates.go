@@ -0,0 +1,103 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/LaughinKuma/tvdb-go-api/client"
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// UpdatesIterator iterates over the updates feed, fetching pages by
+// following links.next as needed. Stop calling Next to end early.
+//
+//	it := endpoints.NewUpdatesIterator(ctx, c, since, &endpoints.UpdatesOptions{Type: models.UpdateEntitySeries})
+//	for it.Next() {
+//		update := it.Update()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type UpdatesIterator struct {
+	pager *pager[models.EntityUpdate]
+}
+
+// NewUpdatesIterator returns an iterator over the records changed since the
+// given time. No request is made until Next is called.
+func NewUpdatesIterator(ctx context.Context, c client.ClientInterface, since time.Time, opts *UpdatesOptions) *UpdatesIterator {
+	fetch := func(ctx context.Context, page int) ([]models.EntityUpdate, models.Links, error) {
+		response, err := client.GetResponse[[]models.EntityUpdate](ctx, c, updatesPath(since, opts, page))
+		if err != nil {
+			return nil, models.Links{}, fmt.Errorf("failed to get updates: %w", err)
+		}
+		return filterUpdates(response.Data, opts), response.Links, nil
+	}
+	return &UpdatesIterator{pager: newPager(ctx, fetch)}
+}
+
+// Next advances to the next update. It returns false when there are no more
+// updates or an error occurred.
+func (it *UpdatesIterator) Next() bool {
+	return it.pager.next()
+}
+
+// Update returns the current update.
+func (it *UpdatesIterator) Update() models.EntityUpdate {
+	return it.pager.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *UpdatesIterator) Err() error {
+	return it.pager.err
+}
+
+// GetUpdates fetches every record changed since the given time, following
+// all pages of the updates feed.
+func GetUpdates(c client.ClientInterface, since time.Time, opts *UpdatesOptions) ([]models.EntityUpdate, error) {
+	return GetUpdatesContext(context.Background(), c, since, opts)
+}
+
+// GetUpdatesContext is like GetUpdates but uses the provided context.
+func GetUpdatesContext(ctx context.Context, c client.ClientInterface, since time.Time, opts *UpdatesOptions) ([]models.EntityUpdate, error) {
+	var updates []models.EntityUpdate
+	it := NewUpdatesIterator(ctx, c, since, opts)
+	for it.Next() {
+		updates = append(updates, it.Update())
+	}
+	return updates, it.Err()
+}
+
+func updatesPath(since time.Time, opts *UpdatesOptions, page int) string {
+	v := url.Values{}
+	v.Set("since", strconv.FormatInt(since.Unix(), 10))
+	if opts != nil {
+		if opts.Type != "" {
+			v.Set("type", string(opts.Type))
+		}
+		if opts.Action == models.UpdateMethodUpdate || opts.Action == models.UpdateMethodDelete {
+			v.Set("action", string(opts.Action))
+		}
+	}
+	v.Set("page", strconv.Itoa(page))
+
+	return "/updates?" + v.Encode()
+}
+
+// filterUpdates drops the updates that do not match the requested action.
+func filterUpdates(updates []models.EntityUpdate, opts *UpdatesOptions) []models.EntityUpdate {
+	if opts == nil || opts.Action != models.UpdateMethodCreate {
+		return updates
+	}
+
+	filtered := updates[:0]
+	for _, u := range updates {
+		if u.Method == models.UpdateMethodCreate {
+			filtered = append(filtered, u)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,16 @@
+package tvdb
+
+import "github.com/LaughinKuma/tvdb-go-api/client"
+
+// APIError is returned when the TVDB API responds with a non-2xx status code.
+// Use errors.As to inspect it.
+type APIError = client.APIError
+
+// Sentinel errors that errors returned by TVDB methods can be matched
+// against with errors.Is.
+var (
+	ErrNotFound     = client.ErrNotFound
+	ErrUnauthorized = client.ErrUnauthorized
+	ErrRateLimited  = client.ErrRateLimited
+	ErrServer       = client.ErrServer
+)
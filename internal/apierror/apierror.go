@@ -0,0 +1,102 @@
+// Package apierror defines the errors returned for failed TVDB API responses.
+// It is shared by the auth and client packages, which re-export it.
+package apierror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxErrorBody is the maximum number of bytes of a response body kept in an APIError.
+const maxErrorBody = 4096
+
+// Sentinel errors matched by APIError via errors.Is.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServer       = errors.New("server error")
+)
+
+// requestIDHeaders lists the response headers checked, in order, for a request ID.
+var requestIDHeaders = []string{"X-Request-Id", "X-Amzn-Requestid", "Cf-Ray"}
+
+// APIError is returned when the TVDB API responds with a non-2xx status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Status is the "status" field of the TVDB error body, e.g. "failure".
+	Status string
+	// Message is the "message" field of the TVDB error body.
+	Message string
+	// Method and Path identify the request that failed.
+	Method string
+	Path   string
+	// RequestID is the request identifier reported by the server, if any.
+	RequestID string
+	// Body holds the start of the raw response body.
+	Body string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("unexpected status code: %d (%s %s)", e.StatusCode, e.Method, e.Path)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Is reports whether the error matches one of the sentinel errors based on
+// its status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// CheckResponse returns an *APIError if resp does not have a 2xx status code.
+// It reads part of the body but does not close it.
+func CheckResponse(resp *http.Response, method, path string) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     method,
+		Path:       path,
+	}
+
+	for _, h := range requestIDHeaders {
+		if id := resp.Header.Get(h); id != "" {
+			apiErr.RequestID = id
+			break
+		}
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	apiErr.Body = string(body)
+
+	var errBody struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &errBody) == nil {
+		apiErr.Status = errBody.Status
+		apiErr.Message = errBody.Message
+	}
+
+	return apiErr
+}
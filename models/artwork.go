@@ -0,0 +1,141 @@
+package models
+
+import "sort"
+
+// Artwork type IDs as listed by the /artwork/types endpoint
+const (
+	ArtworkTypeSeriesBanner     = 1
+	ArtworkTypeSeriesPoster     = 2
+	ArtworkTypeSeriesBackground = 3
+	ArtworkTypeSeriesIcon       = 5
+	ArtworkTypeSeasonBanner     = 6
+	ArtworkTypeSeasonPoster     = 7
+	ArtworkTypeSeasonBackground = 8
+	ArtworkTypeSeasonIcon       = 10
+	ArtworkTypeMoviePoster      = 14
+	ArtworkTypeMovieBackground  = 15
+	ArtworkTypeMovieBanner      = 16
+	ArtworkTypeMovieIcon        = 18
+	ArtworkTypeSeriesClearArt   = 22
+	ArtworkTypeSeriesClearLogo  = 23
+	ArtworkTypeMovieClearArt    = 24
+	ArtworkTypeMovieClearLogo   = 25
+)
+
+// ArtworkKind groups the artwork types of series, seasons and movies that
+// serve the same purpose
+type ArtworkKind string
+
+// Artwork kinds supported by BestArtwork
+const (
+	ArtworkKindPoster    ArtworkKind = "poster"
+	ArtworkKindBanner    ArtworkKind = "banner"
+	ArtworkKindFanart    ArtworkKind = "fanart"
+	ArtworkKindClearLogo ArtworkKind = "clearlogo"
+)
+
+var artworkKindTypes = map[ArtworkKind][]int{
+	ArtworkKindPoster:    {ArtworkTypeSeriesPoster, ArtworkTypeSeasonPoster, ArtworkTypeMoviePoster},
+	ArtworkKindBanner:    {ArtworkTypeSeriesBanner, ArtworkTypeSeasonBanner, ArtworkTypeMovieBanner},
+	ArtworkKindFanart:    {ArtworkTypeSeriesBackground, ArtworkTypeSeasonBackground, ArtworkTypeMovieBackground},
+	ArtworkKindClearLogo: {ArtworkTypeSeriesClearLogo, ArtworkTypeMovieClearLogo},
+}
+
+// Types returns the artwork type IDs belonging to the kind
+func (k ArtworkKind) Types() []int {
+	return artworkKindTypes[k]
+}
+
+// Kind returns the kind of the artwork's type, or an empty kind if the type
+// belongs to none of them
+func (a Artwork) Kind() ArtworkKind {
+	for kind, types := range artworkKindTypes {
+		for _, t := range types {
+			if a.Type == t {
+				return kind
+			}
+		}
+	}
+	return ""
+}
+
+// ArtworkExtended represents artwork together with the record it belongs to
+type ArtworkExtended struct {
+	Artwork
+	SeriesID        int           `json:"seriesId"`
+	SeasonID        int           `json:"seasonId"`
+	EpisodeID       int           `json:"episodeId"`
+	MovieID         int           `json:"movieId"`
+	PeopleID        int           `json:"peopleId"`
+	NetworkID       int           `json:"networkId"`
+	SeriesPeopleID  int           `json:"seriesPeopleId"`
+	ThumbnailWidth  int           `json:"thumbnailWidth"`
+	ThumbnailHeight int           `json:"thumbnailHeight"`
+	UpdatedAt       int64         `json:"updatedAt"`
+	Status          ArtworkStatus `json:"status"`
+	Tags            []TagOption   `json:"tagOptions"`
+}
+
+// ArtworkType describes an artwork type and its expected dimensions
+type ArtworkType struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	RecordType  string `json:"recordType"`
+	Slug        string `json:"slug"`
+	ImageFormat string `json:"imageFormat"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	ThumbWidth  int    `json:"thumbWidth"`
+	ThumbHeight int    `json:"thumbHeight"`
+}
+
+// ArtworkStatus represents the moderation status of an artwork
+type ArtworkStatus struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// BestArtwork picks the best artwork of the given kind for a language.
+// Artworks in the requested language are preferred, then artworks without
+// a language (which usually contain no text), then any other language.
+// Ties are broken by score and then by resolution. It reports false if no
+// artwork of the kind exists.
+func BestArtwork(artworks []Artwork, kind ArtworkKind, language string) (Artwork, bool) {
+	types := kind.Types()
+
+	var candidates []Artwork
+	for _, a := range artworks {
+		for _, t := range types {
+			if a.Type == t {
+				candidates = append(candidates, a)
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return Artwork{}, false
+	}
+
+	languageRank := func(a Artwork) int {
+		switch a.Language {
+		case language:
+			return 0
+		case "":
+			return 1
+		}
+		return 2
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if ra, rb := languageRank(a), languageRank(b); ra != rb {
+			return ra < rb
+		}
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.Width*a.Height > b.Width*b.Height
+	})
+
+	return candidates[0], true
+}
@@ -0,0 +1,244 @@
+package models
+
+// SeriesExtended represents a TV series together with its related records
+type SeriesExtended struct {
+	Series
+	Artworks        []Artwork     `json:"artworks"`
+	Characters      []Character   `json:"characters"`
+	Seasons         []Season      `json:"seasons"`
+	Episodes        []Episode     `json:"episodes"`
+	RemoteIDs       RemoteIDs     `json:"remoteIds"`
+	Companies       []Company     `json:"companies"`
+	OriginalNetwork *Company      `json:"originalNetwork"`
+	LatestNetwork   *Company      `json:"latestNetwork"`
+	Trailers        []Trailer     `json:"trailers"`
+	Tags            []TagOption   `json:"tags"`
+	Genres          []Genre       `json:"genres"`
+	Translations    *Translations `json:"translations"`
+}
+
+// EpisodeExtended represents a TV episode together with its credits and related records
+type EpisodeExtended struct {
+	Episode
+	Characters   []Character   `json:"characters"`
+	Networks     []Company     `json:"networks"`
+	Companies    []Company     `json:"companies"`
+	Trailers     []Trailer     `json:"trailers"`
+	Awards       []Award       `json:"awards"`
+	RemoteIDs    RemoteIDs     `json:"remoteIds"`
+	Tags         []TagOption   `json:"tags"`
+	Translations *Translations `json:"translations"`
+}
+
+// Credits returns the characters whose people type matches peopleType,
+// e.g. "Guest Star", "Director" or "Writer".
+func (e *EpisodeExtended) Credits(peopleType string) []Character {
+	var credits []Character
+	for _, c := range e.Characters {
+		if c.PeopleType == peopleType {
+			credits = append(credits, c)
+		}
+	}
+	return credits
+}
+
+// SeasonExtended represents a season together with its episodes, artwork and trailers
+type SeasonExtended struct {
+	Season
+	Year         string        `json:"year"`
+	Episodes     []Episode     `json:"episodes"`
+	Artwork      []Artwork     `json:"artwork"`
+	Trailers     []Trailer     `json:"trailers"`
+	Tags         []TagOption   `json:"tagOptions"`
+	Translations *Translations `json:"translations"`
+}
+
+// MovieExtended represents a movie together with its releases, credits and related records
+type MovieExtended struct {
+	Movie
+	Releases            []Release           `json:"releases"`
+	FirstRelease        *Release            `json:"first_release"`
+	BoxOffice           string              `json:"boxOffice"`
+	BoxOfficeUS         string              `json:"boxOfficeUS"`
+	Budget              string              `json:"budget"`
+	Studios             []Studio            `json:"studios"`
+	Companies           Companies           `json:"companies"`
+	ProductionCountries []ProductionCountry `json:"production_countries"`
+	SpokenLanguages     []string            `json:"spoken_languages"`
+	Characters          []Character         `json:"characters"`
+	Artworks            []Artwork           `json:"artworks"`
+	Trailers            []Trailer           `json:"trailers"`
+	Lists               []List              `json:"lists"`
+	Awards              []Award             `json:"awards"`
+	RemoteIDs           RemoteIDs           `json:"remoteIds"`
+	Genres              []Genre             `json:"genres"`
+	Tags                []TagOption         `json:"tags"`
+	Translations        *Translations       `json:"translations"`
+}
+
+// Release represents the release of a movie in one country
+type Release struct {
+	Country string `json:"country"`
+	Date    string `json:"date"`
+	Detail  string `json:"detail"`
+}
+
+// Studio represents a movie studio
+type Studio struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	ParentStudio int    `json:"parentStudio"`
+}
+
+// ProductionCountry represents a country a movie was produced in
+type ProductionCountry struct {
+	ID      int    `json:"id"`
+	Country string `json:"country"`
+	Name    string `json:"name"`
+}
+
+// Companies groups the companies involved in a movie by their role
+type Companies struct {
+	Studio         []Company `json:"studio"`
+	Network        []Company `json:"network"`
+	Production     []Company `json:"production"`
+	Distributor    []Company `json:"distributor"`
+	SpecialEffects []Company `json:"special_effects"`
+}
+
+// List represents a user or official list of series and movies
+type List struct {
+	ID                   int      `json:"id"`
+	Name                 string   `json:"name"`
+	Overview             string   `json:"overview"`
+	URL                  string   `json:"url"`
+	Image                string   `json:"image"`
+	IsOfficial           bool     `json:"isOfficial"`
+	Score                int      `json:"score"`
+	Aliases              []Alias  `json:"aliases"`
+	NameTranslations     []string `json:"nameTranslations"`
+	OverviewTranslations []string `json:"overviewTranslations"`
+}
+
+// PersonExtended represents a person together with their biographies, roles and awards
+type PersonExtended struct {
+	Person
+	Birth        string        `json:"birth"`
+	Death        string        `json:"death"`
+	BirthPlace   string        `json:"birthPlace"`
+	Biographies  []Biography   `json:"biographies"`
+	Characters   []Character   `json:"characters"`
+	Awards       []Award       `json:"awards"`
+	RemoteIDs    RemoteIDs     `json:"remoteIds"`
+	Tags         []TagOption   `json:"tagOptions"`
+	Translations *Translations `json:"translations"`
+}
+
+// Biography represents the biography of a person in one language
+type Biography struct {
+	Biography string `json:"biography"`
+	Language  string `json:"language"`
+}
+
+// Character represents a role played by a person in a series, movie or episode.
+// Exactly one of SeriesID, MovieID and EpisodeID is usually set.
+type Character struct {
+	ID                   int         `json:"id"`
+	Name                 string      `json:"name"`
+	PeopleID             int         `json:"peopleId"`
+	SeriesID             int         `json:"seriesId"`
+	MovieID              int         `json:"movieId"`
+	EpisodeID            int         `json:"episodeId"`
+	Series               *RecordInfo `json:"series"`
+	Movie                *RecordInfo `json:"movie"`
+	Episode              *RecordInfo `json:"episode"`
+	Type                 int         `json:"type"`
+	PeopleType           string      `json:"peopleType"`
+	Sort                 int         `json:"sort"`
+	IsFeatured           bool        `json:"isFeatured"`
+	PersonName           string      `json:"personName"`
+	PersonImgURL         string      `json:"personImgURL"`
+	Image                string      `json:"image"`
+	URL                  string      `json:"url"`
+	Aliases              []Alias     `json:"aliases"`
+	Tags                 []TagOption `json:"tagOptions"`
+	NameTranslations     []string    `json:"nameTranslations"`
+	OverviewTranslations []string    `json:"overviewTranslations"`
+}
+
+// RecordInfo is a short summary of the record a character appears in
+type RecordInfo struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+	Year  string `json:"year"`
+}
+
+// Company represents a network, studio or production company
+type Company struct {
+	ID                   int         `json:"id"`
+	Name                 string      `json:"name"`
+	Slug                 string      `json:"slug"`
+	Country              string      `json:"country"`
+	PrimaryCompanyType   int         `json:"primaryCompanyType"`
+	ActiveDate           string      `json:"activeDate"`
+	InactiveDate         string      `json:"inactiveDate"`
+	CompanyType          CompanyType `json:"companyType"`
+	Aliases              []Alias     `json:"aliases"`
+	NameTranslations     []string    `json:"nameTranslations"`
+	OverviewTranslations []string    `json:"overviewTranslations"`
+}
+
+// CompanyType describes the role of a company
+type CompanyType struct {
+	CompanyTypeID   int    `json:"companyTypeId"`
+	CompanyTypeName string `json:"companyTypeName"`
+}
+
+// Trailer represents a trailer for a series, season, episode or movie
+type Trailer struct {
+	ID       int    `json:"id"`
+	Language string `json:"language"`
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	Runtime  int    `json:"runtime"`
+}
+
+// TagOption represents a tag applied to a record
+type TagOption struct {
+	ID       int    `json:"id"`
+	Tag      int    `json:"tag"`
+	TagName  string `json:"tagName"`
+	Name     string `json:"name"`
+	HelpText string `json:"helpText"`
+}
+
+// Award represents an award won by or nominated for a record
+type Award struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// Genre represents a genre
+type Genre struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// Translation represents the translated fields of a record in one language
+type Translation struct {
+	Language  string   `json:"language"`
+	Name      string   `json:"name"`
+	Overview  string   `json:"overview"`
+	Aliases   []string `json:"aliases"`
+	IsAlias   bool     `json:"isAlias"`
+	IsPrimary bool     `json:"isPrimary"`
+	Tagline   string   `json:"tagline"`
+}
+
+// Translations holds all translations included with meta=translations
+type Translations struct {
+	NameTranslations     []Translation `json:"nameTranslations"`
+	OverviewTranslations []Translation `json:"overviewTranslations"`
+	Aliases              []string      `json:"alias"`
+}
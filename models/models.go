@@ -17,98 +17,136 @@ func (ct *CustomTime) UnmarshalJSON(b []byte) error {
 	s := string(b)
 	// Remove quotes
 	s = s[1 : len(s)-1]
-	
+
 	// Parse the time using the format returned by the API
 	t, err := time.Parse("2006-01-02 15:04:05", s)
 	if err != nil {
 		return err
 	}
-	
+
 	*ct = CustomTime(t)
 	return nil
 }
 
 // Series represents a TV series
 type Series struct {
-	ID                   int         `json:"id"`
-	Name                 string      `json:"name"`
-	Slug                 string      `json:"slug"`
-	Image                string      `json:"image"`
-	FirstAired           string      `json:"firstAired"`
-	LastAired            string      `json:"lastAired"`
-	NextAired            string      `json:"nextAired"`
-	Status               Status      `json:"status"`
-	Overview             string      `json:"overview"`
-	Network              string      `json:"network"`
-	Runtime              int         `json:"runtime"`
-	Language             string      `json:"language"`
-	Genre                []string    `json:"genre"`
-	LastUpdated          CustomTime  `json:"lastUpdated"`
-	AverageRating        float64     `json:"averageRating"`
-	OriginalCountry      string      `json:"originalCountry"`
-	OriginalLanguage     string      `json:"originalLanguage"`
-	ContentRating        string      `json:"contentRating"`
-	ImdbID               string      `json:"imdbId"`
-	ZapID                string      `json:"zap2itId"`
-	Aliases              []Alias     `json:"aliases"`
-	NameTranslations     []string    `json:"nameTranslations"`
-	OverviewTranslations []string    `json:"overviewTranslations"`
-}
+	ID                   int        `json:"id"`
+	Name                 string     `json:"name"`
+	Slug                 string     `json:"slug"`
+	Image                string     `json:"image"`
+	FirstAired           string     `json:"firstAired"`
+	LastAired            string     `json:"lastAired"`
+	NextAired            string     `json:"nextAired"`
+	Status               Status     `json:"status"`
+	Overview             string     `json:"overview"`
+	Network              string     `json:"network"`
+	Runtime              int        `json:"runtime"`
+	Language             string     `json:"language"`
+	Genre                []string   `json:"genre"`
+	LastUpdated          CustomTime `json:"lastUpdated"`
+	AverageRating        float64    `json:"averageRating"`
+	OriginalCountry      string     `json:"originalCountry"`
+	OriginalLanguage     string     `json:"originalLanguage"`
+	ContentRating        string     `json:"contentRating"`
+	ImdbID               string     `json:"imdbId"`
+	ZapID                string     `json:"zap2itId"`
+	Aliases              []Alias    `json:"aliases"`
+	NameTranslations     []string   `json:"nameTranslations"`
+	OverviewTranslations []string   `json:"overviewTranslations"`
 
+	// Localization records the language of Name and Overview
+	Localization Localization `json:"-"`
+}
 
+// Season represents a season of a TV series in one episode ordering
 type Season struct {
-	ID                 int       `json:"id"`
-	SeriesID           int       `json:"seriesId"`
-	Number             int       `json:"number"`
-	Name               string    `json:"name"`
-	EpisodeCount       int       `json:"episodeCount"`
-	Overview           string    `json:"overview"`
-	Image              string    `json:"image"`
-	NetworkID          int       `json:"networkId"`
-	LastUpdated        time.Time `json:"lastUpdated"`
-	NameTranslations   []string  `json:"nameTranslations"`
-	OverviewTranslations []string  `json:"overviewTranslations"`
+	ID                   int        `json:"id"`
+	SeriesID             int        `json:"seriesId"`
+	Type                 SeasonType `json:"type"`
+	Number               int        `json:"number"`
+	Name                 string     `json:"name"`
+	EpisodeCount         int        `json:"episodeCount"`
+	Overview             string     `json:"overview"`
+	Image                string     `json:"image"`
+	NetworkID            int        `json:"networkId"`
+	LastUpdated          CustomTime `json:"lastUpdated"`
+	NameTranslations     []string   `json:"nameTranslations"`
+	OverviewTranslations []string   `json:"overviewTranslations"`
+}
+
+// SeasonTypeName identifies an episode ordering, such as aired or DVD order
+type SeasonTypeName string
+
+// Episode orderings supported by TVDB
+const (
+	SeasonTypeAired     SeasonTypeName = "official"
+	SeasonTypeDVD       SeasonTypeName = "dvd"
+	SeasonTypeAbsolute  SeasonTypeName = "absolute"
+	SeasonTypeAlternate SeasonTypeName = "alternate"
+	SeasonTypeRegional  SeasonTypeName = "regional"
+	SeasonTypeAltDVD    SeasonTypeName = "altdvd"
+)
+
+// SeasonType describes the episode ordering a season belongs to
+type SeasonType struct {
+	ID            int            `json:"id"`
+	Name          string         `json:"name"`
+	Type          SeasonTypeName `json:"type"`
+	AlternateName string         `json:"alternateName"`
 }
 
 // Episode represents a TV episode
 type Episode struct {
-	ID                 int         `json:"id"`
-	SeriesID           int         `json:"seriesId"`
-	Name               string      `json:"name"`
-	AiredSeason        int         `json:"airedSeason"`
-	AiredEpisodeNumber int         `json:"airedEpisodeNumber"`
-	AiredDate          CustomTime  `json:"airedDate"`
-	Runtime            int         `json:"runtime"`
-	Overview           string      `json:"overview"`
-	Image              string      `json:"image"`
-	ImdbID             string      `json:"imdbId"`
-	LastUpdated        CustomTime  `json:"lastUpdated"`
-	NameTranslations   []string    `json:"nameTranslations"`
-	OverviewTranslations []string  `json:"overviewTranslations"`
-}
+	ID                   int        `json:"id"`
+	SeriesID             int        `json:"seriesId"`
+	Name                 string     `json:"name"`
+	AiredSeason          int        `json:"airedSeason"`
+	AiredEpisodeNumber   int        `json:"airedEpisodeNumber"`
+	AiredDate            CustomTime `json:"airedDate"`
+	Runtime              int        `json:"runtime"`
+	Overview             string     `json:"overview"`
+	Image                string     `json:"image"`
+	ImdbID               string     `json:"imdbId"`
+	LastUpdated          CustomTime `json:"lastUpdated"`
+	NameTranslations     []string   `json:"nameTranslations"`
+	OverviewTranslations []string   `json:"overviewTranslations"`
 
+	// Localization records the language of Name and Overview
+	Localization Localization `json:"-"`
+}
 
 // Movie represents a movie
 type Movie struct {
-	ID                   int         `json:"id"`
-	Name                 string      `json:"name"`
-	Slug                 string      `json:"slug"`
-	Image                string      `json:"image"`
-	ReleaseDate          CustomTime  `json:"releaseDate"`
-	Status               Status      `json:"status"`
-	Overview             string      `json:"overview"`
-	Runtime              int         `json:"runtime"`
-	Language             string      `json:"language"`
-	Genre                []string    `json:"genre"`
-	LastUpdated          CustomTime  `json:"lastUpdated"`
-	AverageRating        float64     `json:"averageRating"`
-	OriginalCountry      string      `json:"originalCountry"`
-	OriginalLanguage     string      `json:"originalLanguage"`
-	ContentRating        string      `json:"contentRating"`
-	ImdbID               string      `json:"imdbId"`
-	Aliases              []Alias     `json:"aliases"`
-	NameTranslations     []string    `json:"nameTranslations"`
-	OverviewTranslations []string    `json:"overviewTranslations"`
+	ID                   int        `json:"id"`
+	Name                 string     `json:"name"`
+	Slug                 string     `json:"slug"`
+	Image                string     `json:"image"`
+	ReleaseDate          CustomTime `json:"releaseDate"`
+	Status               Status     `json:"status"`
+	Overview             string     `json:"overview"`
+	Runtime              int        `json:"runtime"`
+	Language             string     `json:"language"`
+	Genre                []string   `json:"genre"`
+	LastUpdated          CustomTime `json:"lastUpdated"`
+	AverageRating        float64    `json:"averageRating"`
+	OriginalCountry      string     `json:"originalCountry"`
+	OriginalLanguage     string     `json:"originalLanguage"`
+	ContentRating        string     `json:"contentRating"`
+	ImdbID               string     `json:"imdbId"`
+	Aliases              []Alias    `json:"aliases"`
+	NameTranslations     []string   `json:"nameTranslations"`
+	OverviewTranslations []string   `json:"overviewTranslations"`
+
+	// Localization records the language of Name and Overview
+	Localization Localization `json:"-"`
+}
+
+// Localization records the language that localized fields were taken from.
+// An empty language means the field was not localized and is in the
+// language returned by the API.
+type Localization struct {
+	Name     string
+	Overview string
 }
 
 // Status represents the status of a series or movie
@@ -119,47 +157,83 @@ type Status struct {
 
 // Person represents an actor, director, or other person associated with a series or movie
 type Person struct {
-	ID          int         `json:"id"`
-	Name        string      `json:"name"`
-	Image       string      `json:"image"`
-	BirthDate   CustomTime  `json:"birthDate"`
-	DeathDate   CustomTime  `json:"deathDate"`
-	Gender      int         `json:"gender"`
-	LastUpdated CustomTime  `json:"lastUpdated"`
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Image       string     `json:"image"`
+	BirthDate   CustomTime `json:"birthDate"`
+	DeathDate   CustomTime `json:"deathDate"`
+	Gender      int        `json:"gender"`
+	LastUpdated CustomTime `json:"lastUpdated"`
+	Score       int        `json:"score"`
+	Aliases     []Alias    `json:"aliases"`
+
+	NameTranslations     []string `json:"nameTranslations"`
+	OverviewTranslations []string `json:"overviewTranslations"`
+}
+
+// PeopleType represents the role of a person, such as actor or director
+type PeopleType struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
 }
 
 // Artwork represents artwork associated with a series, movie, or person
 type Artwork struct {
-	ID       int    `json:"id"`
-	Language string `json:"language"`
-	Type     int    `json:"type"`
-	Score    int    `json:"score"`
-	URL      string `json:"url"`
-	Thumbnail string `json:"thumbnail"`
-	Width    int    `json:"width"`
-	Height   int    `json:"height"`
+	ID           int    `json:"id"`
+	Language     string `json:"language"`
+	Type         int    `json:"type"`
+	Score        int    `json:"score"`
+	URL          string `json:"image"`
+	Thumbnail    string `json:"thumbnail"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	IncludesText bool   `json:"includesText"`
 }
 
 // SearchResult represents a search result from the TVDB API
 type SearchResult struct {
-	ObjectID string `json:"objectID"`
-	Type     string `json:"type"`
-	Name     string `json:"name"`
-	Image    string `json:"image_url"`
-	Overview string `json:"overview"`
+	ObjectID        string    `json:"objectID"`
+	Type            string    `json:"type"`
+	Name            string    `json:"name"`
+	Image           string    `json:"image_url"`
+	Overview        string    `json:"overview"`
+	ID              string    `json:"id"`
+	TVDBID          string    `json:"tvdb_id"`
+	Slug            string    `json:"slug"`
+	Year            string    `json:"year"`
+	FirstAirTime    string    `json:"first_air_time"`
+	Status          string    `json:"status"`
+	Country         string    `json:"country"`
+	Network         string    `json:"network"`
+	Director        string    `json:"director"`
+	PrimaryLanguage string    `json:"primary_language"`
+	PrimaryType     string    `json:"primary_type"`
+	Thumbnail       string    `json:"thumbnail"`
+	Aliases         []string  `json:"aliases"`
+	RemoteIDs       RemoteIDs `json:"remote_ids"`
+}
+
+// Links holds the pagination links returned with list responses
+type Links struct {
+	Prev       string `json:"prev"`
+	Self       string `json:"self"`
+	Next       string `json:"next"`
+	TotalItems int    `json:"total_items"`
+	PageSize   int    `json:"page_size"`
 }
 
-type SeriesEpisodesResponse struct {
+// Response is the envelope wrapping every TVDB API response
+type Response[T any] struct {
 	Status string `json:"status"`
-	Data   struct {
-		Series   Series    `json:"series"`
-		Episodes []Episode `json:"episodes"`
-	} `json:"data"`
-	Links struct {
-		Prev       string `json:"prev"`
-		Self       string `json:"self"`
-		Next       string `json:"next"`
-		TotalItems int    `json:"total_items"`
-		PageSize   int    `json:"page_size"`
-	} `json:"links"`
+	Data   T      `json:"data"`
+	Links  Links  `json:"links"`
 }
+
+// SeriesEpisodes is the data returned by the series episodes endpoint
+type SeriesEpisodes struct {
+	Series   Series    `json:"series"`
+	Episodes []Episode `json:"episodes"`
+}
+
+// SeriesEpisodesResponse is the response of the series episodes endpoint
+type SeriesEpisodesResponse = Response[SeriesEpisodes]
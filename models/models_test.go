@@ -110,4 +110,322 @@ func TestSearchResultJSONTags(t *testing.T) {
 	assert.Contains(t, string(jsonData), `"name":"Test Series"`)
 	assert.Contains(t, string(jsonData), `"image_url":"http://example.com/image.jpg"`)
 	assert.Contains(t, string(jsonData), `"overview":"Test overview"`)
-}
\ No newline at end of file
+}
+
+func TestResponseUnmarshalJSON(t *testing.T) {
+	input := `{
+		"status": "success",
+		"data": {"episodes": [{"id": 1, "name": "Pilot"}]},
+		"links": {"prev": null, "self": "https://example.com?page=0", "next": "https://example.com?page=1", "total_items": 600, "page_size": 500}
+	}`
+
+	var response SeriesEpisodesResponse
+	err := json.Unmarshal([]byte(input), &response)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "success", response.Status)
+	assert.Equal(t, []Episode{{ID: 1, Name: "Pilot"}}, response.Data.Episodes)
+	assert.Equal(t, Links{
+		Self:       "https://example.com?page=0",
+		Next:       "https://example.com?page=1",
+		TotalItems: 600,
+		PageSize:   500,
+	}, response.Links)
+}
+
+func TestSeriesExtendedUnmarshalJSON(t *testing.T) {
+	input := `{
+		"id": 81189,
+		"name": "Breaking Bad",
+		"slug": "breaking-bad",
+		"lastUpdated": "2023-05-15 14:30:00",
+		"artworks": [{"id": 1, "type": 2, "language": "eng"}],
+		"characters": [{"id": 10, "name": "Walter White", "peopleId": 20, "personName": "Bryan Cranston", "isFeatured": true}],
+		"seasons": [{"id": 30, "seriesId": 81189, "number": 1}],
+		"remoteIds": [{"id": "tt0903747", "type": 2, "sourceName": "IMDB"}],
+		"companies": [{"id": 40, "name": "AMC", "companyType": {"companyTypeId": 1, "companyTypeName": "Network"}}],
+		"originalNetwork": {"id": 40, "name": "AMC"},
+		"trailers": [{"id": 50, "name": "Trailer", "url": "https://example.com/trailer"}],
+		"tags": [{"id": 60, "tagName": "Drug Trafficking"}],
+		"genres": [{"id": 70, "name": "Drama", "slug": "drama"}],
+		"translations": {
+			"nameTranslations": [{"language": "deu", "name": "Breaking Bad", "isPrimary": true}],
+			"overviewTranslations": [{"language": "deu", "overview": "Walter White ist Chemielehrer."}],
+			"alias": ["BB"]
+		}
+	}`
+
+	var series SeriesExtended
+	err := json.Unmarshal([]byte(input), &series)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 81189, series.ID)
+	assert.Equal(t, "Breaking Bad", series.Name)
+	assert.Equal(t, time.Date(2023, 5, 15, 14, 30, 0, 0, time.UTC), time.Time(series.LastUpdated))
+	assert.Equal(t, "Bryan Cranston", series.Characters[0].PersonName)
+	assert.Equal(t, 1, series.Seasons[0].Number)
+	assert.Equal(t, "tt0903747", series.RemoteIDs[0].ID)
+	assert.Equal(t, "Network", series.Companies[0].CompanyType.CompanyTypeName)
+	assert.Equal(t, "AMC", series.OriginalNetwork.Name)
+	assert.Nil(t, series.LatestNetwork)
+	assert.Equal(t, "https://example.com/trailer", series.Trailers[0].URL)
+	assert.Equal(t, "Drug Trafficking", series.Tags[0].TagName)
+	assert.Equal(t, "drama", series.Genres[0].Slug)
+	assert.True(t, series.Translations.NameTranslations[0].IsPrimary)
+	assert.Equal(t, []string{"BB"}, series.Translations.Aliases)
+}
+
+func TestEpisodeExtendedCredits(t *testing.T) {
+	input := `{
+		"id": 349232,
+		"name": "Pilot",
+		"characters": [
+			{"id": 1, "personName": "Vince Gilligan", "peopleType": "Writer"},
+			{"id": 2, "personName": "Vince Gilligan", "peopleType": "Director"},
+			{"id": 3, "personName": "Guest One", "peopleType": "Guest Star"},
+			{"id": 4, "personName": "Guest Two", "peopleType": "Guest Star"}
+		],
+		"networks": [{"id": 40, "name": "AMC"}],
+		"awards": [{"id": 5, "name": "Emmy"}],
+		"remoteIds": [{"id": "tt0959621", "type": 2, "sourceName": "IMDB"}]
+	}`
+
+	var episode EpisodeExtended
+	err := json.Unmarshal([]byte(input), &episode)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "AMC", episode.Networks[0].Name)
+	assert.Equal(t, "Emmy", episode.Awards[0].Name)
+	assert.Equal(t, "tt0959621", episode.RemoteIDs[0].ID)
+
+	guests := episode.Credits("Guest Star")
+	assert.Len(t, guests, 2)
+	assert.Equal(t, "Guest One", guests[0].PersonName)
+	assert.Len(t, episode.Credits("Director"), 1)
+	assert.Empty(t, episode.Credits("Producer"))
+}
+
+func TestMovieExtendedUnmarshalJSON(t *testing.T) {
+	input := `{
+		"id": 1,
+		"name": "Joker",
+		"releases": [{"country": "usa", "date": "2019-10-04", "detail": null}],
+		"first_release": {"country": "global", "date": "2019-08-31"},
+		"boxOffice": "1074251311",
+		"budget": "55000000",
+		"studios": [{"id": 2, "name": "DC Films", "parentStudio": 0}],
+		"companies": {"studio": [{"id": 3, "name": "Warner Bros."}], "production": [], "distributor": [{"id": 4, "name": "Warner Bros. Pictures"}]},
+		"production_countries": [{"id": 5, "country": "usa", "name": "United States of America"}],
+		"spoken_languages": ["eng"],
+		"lists": [{"id": 6, "name": "DC Films", "isOfficial": true}],
+		"trailers": [{"id": 7, "url": "https://example.com/trailer"}]
+	}`
+
+	var movie MovieExtended
+	err := json.Unmarshal([]byte(input), &movie)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Joker", movie.Name)
+	assert.Equal(t, []Release{{Country: "usa", Date: "2019-10-04"}}, movie.Releases)
+	assert.Equal(t, "global", movie.FirstRelease.Country)
+	assert.Equal(t, "1074251311", movie.BoxOffice)
+	assert.Equal(t, "55000000", movie.Budget)
+	assert.Equal(t, "DC Films", movie.Studios[0].Name)
+	assert.Equal(t, "Warner Bros.", movie.Companies.Studio[0].Name)
+	assert.Equal(t, "Warner Bros. Pictures", movie.Companies.Distributor[0].Name)
+	assert.Equal(t, "United States of America", movie.ProductionCountries[0].Name)
+	assert.Equal(t, []string{"eng"}, movie.SpokenLanguages)
+	assert.True(t, movie.Lists[0].IsOfficial)
+	assert.Equal(t, "https://example.com/trailer", movie.Trailers[0].URL)
+}
+
+func TestSeasonTypeUnmarshalJSON(t *testing.T) {
+	input := `{"id": 30, "seriesId": 81189, "number": 1, "type": {"id": 2, "name": "DVD Order", "type": "dvd", "alternateName": null}}`
+
+	var season Season
+	err := json.Unmarshal([]byte(input), &season)
+
+	assert.NoError(t, err)
+	assert.Equal(t, SeasonType{ID: 2, Name: "DVD Order", Type: SeasonTypeDVD}, season.Type)
+}
+
+func TestSeasonLastUpdatedUnmarshalJSON(t *testing.T) {
+	input := `{"id": 30, "seriesId": 81189, "number": 1, "lastUpdated": "2021-05-20 08:36:36"}`
+
+	var season Season
+	err := json.Unmarshal([]byte(input), &season)
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 5, 20, 8, 36, 36, 0, time.UTC), time.Time(season.LastUpdated))
+}
+
+func TestPersonExtendedUnmarshalJSON(t *testing.T) {
+	input := `{
+		"id": 17,
+		"name": "Bryan Cranston",
+		"aliases": [{"language": "eng", "name": "Bryan Lee Cranston"}],
+		"birth": "1956-03-07",
+		"death": null,
+		"birthPlace": "Hollywood, California, USA",
+		"biographies": [{"biography": "American actor.", "language": "eng"}],
+		"characters": [{"id": 1, "name": "Walter White"}, {"id": 2, "name": "Hal"}],
+		"awards": [{"id": 3, "name": "Emmy"}],
+		"remoteIds": [{"id": "nm0186505", "type": 2, "sourceName": "IMDB"}]
+	}`
+
+	var person PersonExtended
+	err := json.Unmarshal([]byte(input), &person)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Bryan Cranston", person.Name)
+	assert.Equal(t, "Bryan Lee Cranston", person.Aliases[0].Name)
+	assert.Equal(t, "1956-03-07", person.Birth)
+	assert.Equal(t, "", person.Death)
+	assert.Equal(t, "Hollywood, California, USA", person.BirthPlace)
+	assert.Equal(t, "American actor.", person.Biographies[0].Biography)
+	assert.Len(t, person.Characters, 2)
+	assert.Equal(t, "Emmy", person.Awards[0].Name)
+	assert.Equal(t, "nm0186505", person.RemoteIDs[0].ID)
+}
+
+func TestCharacterUnmarshalJSON(t *testing.T) {
+	input := `{
+		"id": 1,
+		"name": "Walter White",
+		"peopleId": 17,
+		"seriesId": 81189,
+		"series": {"name": "Breaking Bad", "image": "https://example.com/poster.jpg", "year": "2008"},
+		"movieId": null,
+		"movie": null,
+		"episodeId": null,
+		"episode": null,
+		"type": 3,
+		"peopleType": "Actor",
+		"sort": 0,
+		"isFeatured": true,
+		"personName": "Bryan Cranston",
+		"personImgURL": "https://example.com/person.jpg",
+		"image": "https://example.com/character.jpg"
+	}`
+
+	var character Character
+	err := json.Unmarshal([]byte(input), &character)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Character{
+		ID:           1,
+		Name:         "Walter White",
+		PeopleID:     17,
+		SeriesID:     81189,
+		Series:       &RecordInfo{Name: "Breaking Bad", Image: "https://example.com/poster.jpg", Year: "2008"},
+		Type:         3,
+		PeopleType:   "Actor",
+		IsFeatured:   true,
+		PersonName:   "Bryan Cranston",
+		PersonImgURL: "https://example.com/person.jpg",
+		Image:        "https://example.com/character.jpg",
+	}, character)
+}
+
+func TestArtworkUnmarshalJSON(t *testing.T) {
+	input := `{"id": 5, "image": "https://example.com/poster.jpg", "thumbnail": "https://example.com/poster_t.jpg", "language": "eng", "type": 2, "score": 100, "width": 680, "height": 1000, "includesText": true}`
+
+	var artwork Artwork
+	err := json.Unmarshal([]byte(input), &artwork)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Artwork{
+		ID:           5,
+		Language:     "eng",
+		Type:         ArtworkTypeSeriesPoster,
+		Score:        100,
+		URL:          "https://example.com/poster.jpg",
+		Thumbnail:    "https://example.com/poster_t.jpg",
+		Width:        680,
+		Height:       1000,
+		IncludesText: true,
+	}, artwork)
+}
+
+func TestBestArtwork(t *testing.T) {
+	artworks := []Artwork{
+		{ID: 1, Type: ArtworkTypeSeriesBanner, Language: "deu", Score: 1000},
+		{ID: 2, Type: ArtworkTypeSeriesPoster, Language: "eng", Score: 50, Width: 680, Height: 1000},
+		{ID: 3, Type: ArtworkTypeSeriesPoster, Language: "deu", Score: 10, Width: 680, Height: 1000},
+		{ID: 4, Type: ArtworkTypeSeriesPoster, Language: "deu", Score: 10, Width: 1360, Height: 2000},
+		{ID: 5, Type: ArtworkTypeSeriesBackground, Language: "", Score: 5},
+		{ID: 6, Type: ArtworkTypeSeriesBackground, Language: "eng", Score: 500},
+		{ID: 7, Type: ArtworkTypeMovieClearLogo, Language: "fra", Score: 1},
+	}
+
+	tests := []struct {
+		name     string
+		kind     ArtworkKind
+		language string
+		wantID   int
+		wantOK   bool
+	}{
+		{"exact language wins over score", ArtworkKindPoster, "eng", 2, true},
+		{"resolution breaks score ties", ArtworkKindPoster, "deu", 4, true},
+		{"language neutral before other languages", ArtworkKindFanart, "deu", 5, true},
+		{"falls back to any language", ArtworkKindClearLogo, "eng", 7, true},
+		{"no artwork of kind", ArtworkKind("unknown"), "eng", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			artwork, ok := BestArtwork(artworks, tt.kind, tt.language)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantID, artwork.ID)
+		})
+	}
+}
+
+func TestEntityUpdateUnmarshalJSON(t *testing.T) {
+	input := `{"entityType": "series", "method": "delete", "methodInt": 3, "recordType": "series", "recordId": 81189, "seriesId": 81189, "mergeToId": 82066, "mergeToEntityType": "series", "timeStamp": 1700000000, "extraInfo": "", "userId": 1}`
+
+	var update EntityUpdate
+	err := json.Unmarshal([]byte(input), &update)
+
+	assert.NoError(t, err)
+	assert.Equal(t, UpdateEntitySeries, update.EntityType)
+	assert.Equal(t, UpdateMethodDelete, update.Method)
+	assert.Equal(t, 81189, update.RecordID)
+	assert.Equal(t, 82066, update.MergeToID)
+	assert.True(t, time.Unix(1700000000, 0).Equal(update.Time()))
+}
+
+func TestRemoteIDs(t *testing.T) {
+	input := `[
+		{"id": "tt0903747", "type": 2, "sourceName": "IMDB"},
+		{"id": "1396", "type": 12, "sourceName": "TheMovieDB.com"}
+	]`
+
+	var ids RemoteIDs
+	assert.NoError(t, json.Unmarshal([]byte(input), &ids))
+
+	imdb, ok := ids.IMDB()
+	assert.True(t, ok)
+	assert.Equal(t, "tt0903747", imdb)
+
+	tmdb, ok := ids.BySourceName("themoviedb.com")
+	assert.True(t, ok)
+	assert.Equal(t, "1396", tmdb)
+
+	_, ok = ids.Get(SourceTypeZap2It)
+	assert.False(t, ok)
+}
+
+func TestRemoteIDResultUnmarshalJSON(t *testing.T) {
+	input := `[{"series": {"id": 81189, "name": "Breaking Bad"}}, {"people": {"id": 17, "name": "Bryan Cranston"}}]`
+
+	var results []RemoteIDResult
+	err := json.Unmarshal([]byte(input), &results)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []RemoteIDResult{
+		{Series: &Series{ID: 81189, Name: "Breaking Bad"}},
+		{People: &Person{ID: 17, Name: "Bryan Cranston"}},
+	}, results)
+}
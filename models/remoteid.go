@@ -0,0 +1,72 @@
+package models
+
+import "strings"
+
+// Source type IDs of common external databases, as listed by the
+// /sources/types endpoint
+const (
+	SourceTypeIMDB   = 2
+	SourceTypeZap2It = 3
+	SourceTypeTMDB   = 12
+)
+
+// RemoteID represents the ID of a record in an external database
+type RemoteID struct {
+	ID         string `json:"id"`
+	Type       int    `json:"type"`
+	SourceName string `json:"sourceName"`
+}
+
+// RemoteIDs lists the IDs of a record in external databases
+type RemoteIDs []RemoteID
+
+// Get returns the ID in the external database with the given source type
+func (ids RemoteIDs) Get(sourceType int) (string, bool) {
+	for _, id := range ids {
+		if id.Type == sourceType {
+			return id.ID, true
+		}
+	}
+	return "", false
+}
+
+// BySourceName returns the ID in the external database with the given name,
+// e.g. "EIDR", ignoring case
+func (ids RemoteIDs) BySourceName(name string) (string, bool) {
+	for _, id := range ids {
+		if strings.EqualFold(id.SourceName, name) {
+			return id.ID, true
+		}
+	}
+	return "", false
+}
+
+// IMDB returns the IMDb ID, e.g. "tt0903747"
+func (ids RemoteIDs) IMDB() (string, bool) {
+	return ids.Get(SourceTypeIMDB)
+}
+
+// TMDB returns the TheMovieDB ID
+func (ids RemoteIDs) TMDB() (string, bool) {
+	return ids.Get(SourceTypeTMDB)
+}
+
+// SourceType describes an external database that remote IDs refer to
+type SourceType struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Slug    string `json:"slug"`
+	Prefix  string `json:"prefix"`
+	Postfix string `json:"postfix"`
+	Sort    int    `json:"sort"`
+}
+
+// RemoteIDResult is a record found by its remote ID. Only the field
+// matching the type of the record is set.
+type RemoteIDResult struct {
+	Series  *Series  `json:"series"`
+	Movie   *Movie   `json:"movie"`
+	Episode *Episode `json:"episode"`
+	People  *Person  `json:"people"`
+	Company *Company `json:"company"`
+}
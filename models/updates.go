@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// UpdateEntityType identifies the kind of record an update refers to
+type UpdateEntityType string
+
+// Entity types reported by the updates feed
+const (
+	UpdateEntityArtwork              UpdateEntityType = "artwork"
+	UpdateEntityCompanies            UpdateEntityType = "companies"
+	UpdateEntityEpisodes             UpdateEntityType = "episodes"
+	UpdateEntityLists                UpdateEntityType = "lists"
+	UpdateEntityMovies               UpdateEntityType = "movies"
+	UpdateEntityPeople               UpdateEntityType = "people"
+	UpdateEntitySeasons              UpdateEntityType = "seasons"
+	UpdateEntitySeries               UpdateEntityType = "series"
+	UpdateEntitySeriesPeople         UpdateEntityType = "seriespeople"
+	UpdateEntityTranslatedEpisodes   UpdateEntityType = "translatedepisodes"
+	UpdateEntityTranslatedMovies     UpdateEntityType = "translatedmovies"
+	UpdateEntityTranslatedPeople     UpdateEntityType = "translatedpeople"
+	UpdateEntityTranslatedSeasons    UpdateEntityType = "translatedseasons"
+	UpdateEntityTranslatedSeries     UpdateEntityType = "translatedseries"
+	UpdateEntityTranslatedCharacters UpdateEntityType = "translatedcharacters"
+)
+
+// UpdateMethod is the change recorded by an update
+type UpdateMethod string
+
+// Update methods, matching MethodInt 1, 2 and 3
+const (
+	UpdateMethodCreate UpdateMethod = "create"
+	UpdateMethodUpdate UpdateMethod = "update"
+	UpdateMethodDelete UpdateMethod = "delete"
+)
+
+// EntityUpdate is an entry of the updates feed describing a changed record
+type EntityUpdate struct {
+	EntityType        UpdateEntityType `json:"entityType"`
+	Method            UpdateMethod     `json:"method"`
+	MethodInt         int              `json:"methodInt"`
+	RecordType        string           `json:"recordType"`
+	RecordID          int              `json:"recordId"`
+	SeriesID          int              `json:"seriesId"`
+	MergeToID         int              `json:"mergeToId"`
+	MergeToEntityType string           `json:"mergeToEntityType"`
+	TimeStamp         int64            `json:"timeStamp"`
+	ExtraInfo         string           `json:"extraInfo"`
+	UserID            int              `json:"userId"`
+}
+
+// Time returns the time of the update
+func (u EntityUpdate) Time() time.Time {
+	return time.Unix(u.TimeStamp, 0)
+}
@@ -0,0 +1,137 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/LaughinKuma/tvdb-go-api/client"
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// ResultType restricts a search to one type of record.
+type ResultType string
+
+// Record types that can be searched for
+const (
+	TypeSeries  ResultType = "series"
+	TypeMovie   ResultType = "movie"
+	TypePerson  ResultType = "person"
+	TypeCompany ResultType = "company"
+)
+
+// Query holds the parameters of a search. Zero fields are not sent.
+type Query struct {
+	// Query is the text to search for.
+	Query string
+	// Type restricts the results to one type of record.
+	Type ResultType
+	// Year restricts the results to records from the given year.
+	Year int
+	// Company restricts the results to records of a production company.
+	Company string
+	// Country is a three-letter country code, e.g. "usa".
+	Country string
+	// Director restricts movie results to a director.
+	Director string
+	// Language is a three-letter language code, e.g. "eng".
+	Language string
+	// PrimaryType restricts company results to a company type.
+	PrimaryType string
+	// Network restricts series results to a network.
+	Network string
+	// RemoteID restricts the results to records with an external ID.
+	RemoteID string
+	// Offset skips the given number of results.
+	Offset int
+	// Limit sets the maximum number of results returned.
+	Limit int
+}
+
+// encode returns the query string for the query, without the leading "?".
+func (q Query) encode() string {
+	v := url.Values{}
+	set := func(key, value string) {
+		if value != "" {
+			v.Set(key, value)
+		}
+	}
+	setInt := func(key string, value int) {
+		if value != 0 {
+			v.Set(key, strconv.Itoa(value))
+		}
+	}
+
+	set("query", q.Query)
+	set("type", string(q.Type))
+	setInt("year", q.Year)
+	set("company", q.Company)
+	set("country", q.Country)
+	set("director", q.Director)
+	set("language", q.Language)
+	set("primaryType", q.PrimaryType)
+	set("network", q.Network)
+	set("remote_id", q.RemoteID)
+	setInt("offset", q.Offset)
+	setInt("limit", q.Limit)
+
+	return v.Encode()
+}
+
+// Results is one page of search results.
+type Results struct {
+	Results []models.SearchResult
+	Links   models.Links
+
+	query Query
+}
+
+// Next returns the query for the page after this one. It reports false on
+// the last page.
+//
+//	q := search.Query{Query: "office", Type: search.TypeSeries}
+//	for {
+//		results, err := search.SearchWithOptions(c, q)
+//		...
+//		next, ok := results.Next()
+//		if !ok {
+//			break
+//		}
+//		q = next
+//	}
+func (r *Results) Next() (Query, bool) {
+	if len(r.Results) == 0 {
+		return Query{}, false
+	}
+
+	next := r.query
+	next.Offset += len(r.Results)
+
+	if r.Links.TotalItems > 0 {
+		if next.Offset >= r.Links.TotalItems {
+			return Query{}, false
+		}
+	} else if r.Links.Next == "" {
+		return Query{}, false
+	}
+	return next, true
+}
+
+// SearchWithOptions searches with every filter of the query and returns one
+// page of results.
+func SearchWithOptions(c ClientInterface, q Query) (*Results, error) {
+	return SearchWithOptionsContext(context.Background(), c, q)
+}
+
+// SearchWithOptionsContext is like SearchWithOptions but uses the provided context.
+func SearchWithOptionsContext(ctx context.Context, c ClientInterface, q Query) (*Results, error) {
+	path := "/search?" + q.encode()
+
+	response, err := client.GetResponse[[]models.SearchResult](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("search request failed: %w", err)
+	}
+
+	return &Results{Results: response.Data, Links: response.Links, query: q}, nil
+}
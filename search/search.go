@@ -1,28 +1,49 @@
 package search
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 
+	"github.com/LaughinKuma/tvdb-go-api/client"
 	"github.com/LaughinKuma/tvdb-go-api/models"
 )
 
 // ClientInterface defines the methods we need from the client
 type ClientInterface interface {
-	Get(path string, result interface{}) error
+	GetContext(ctx context.Context, path string, result interface{}) error
 }
 
 func Search(c ClientInterface, query string) ([]models.SearchResult, error) {
+	return SearchContext(context.Background(), c, query)
+}
+
+// SearchContext is like Search but uses the provided context for the request.
+func SearchContext(ctx context.Context, c ClientInterface, query string) ([]models.SearchResult, error) {
 	path := fmt.Sprintf("/search?query=%s", url.QueryEscape(query))
-	
-	var response struct {
-		Data []models.SearchResult `json:"data"`
-	}
 
-	err := c.Get(path, &response)
+	response, err := client.GetResponse[[]models.SearchResult](ctx, c, path)
 	if err != nil {
 		return nil, fmt.Errorf("search request failed: %w", err)
 	}
 
 	return response.Data, nil
-}
\ No newline at end of file
+}
+
+// ByRemoteID finds the records with an ID in an external database, such as
+// an IMDb ID ("tt0903747") or an EIDR.
+func ByRemoteID(c ClientInterface, remoteID string) ([]models.RemoteIDResult, error) {
+	return ByRemoteIDContext(context.Background(), c, remoteID)
+}
+
+// ByRemoteIDContext is like ByRemoteID but uses the provided context.
+func ByRemoteIDContext(ctx context.Context, c ClientInterface, remoteID string) ([]models.RemoteIDResult, error) {
+	path := fmt.Sprintf("/search/remoteid/%s", url.PathEscape(remoteID))
+
+	response, err := client.GetResponse[[]models.RemoteIDResult](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("remote ID search failed: %w", err)
+	}
+
+	return response.Data, nil
+}
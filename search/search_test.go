@@ -1,6 +1,7 @@
 package search
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -14,7 +15,7 @@ type MockClient struct {
 	mock.Mock
 }
 
-func (m *MockClient) Get(path string, result interface{}) error {
+func (m *MockClient) GetContext(ctx context.Context, path string, result interface{}) error {
 	args := m.Called(path, result)
 	return args.Error(0)
 }
@@ -61,9 +62,9 @@ func TestSearch(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockClient := new(MockClient)
 
-			mockClient.On("Get", mock.AnythingOfType("string"), mock.AnythingOfType("*struct { Data []models.SearchResult \"json:\\\"data\\\"\" }")).
+			mockClient.On("GetContext", mock.AnythingOfType("string"), mock.AnythingOfType("*models.Response[[]github.com/LaughinKuma/tvdb-go-api/models.SearchResult]")).
 				Run(func(args mock.Arguments) {
-					result := args.Get(1).(*struct{ Data []models.SearchResult `json:"data"` })
+					result := args.Get(1).(*models.Response[[]models.SearchResult])
 					result.Data = tt.mockResponse
 				}).
 				Return(tt.mockError)
@@ -81,4 +82,99 @@ func TestSearch(t *testing.T) {
 			mockClient.AssertExpectations(t)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestByRemoteID(t *testing.T) {
+	mockClient := new(MockClient)
+	expected := []models.RemoteIDResult{
+		{Series: &models.Series{ID: 81189, Name: "Breaking Bad"}},
+	}
+
+	mockClient.On("GetContext", "/search/remoteid/tt0903747", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*models.Response[[]models.RemoteIDResult]).Data = expected
+		}).
+		Return(nil)
+
+	results, err := ByRemoteID(mockClient, "tt0903747")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, results)
+	mockClient.AssertExpectations(t)
+}
+
+func TestSearchWithOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		query Query
+		path  string
+	}{
+		{"text only", Query{Query: "the office"}, "/search?query=the+office"},
+		{
+			name: "all filters",
+			query: Query{
+				Query:       "office",
+				Type:        TypeSeries,
+				Year:        2005,
+				Company:     "NBC Universal",
+				Country:     "usa",
+				Director:    "Ken Kwapis",
+				Language:    "eng",
+				PrimaryType: "network",
+				Network:     "NBC",
+				RemoteID:    "tt0386676",
+				Offset:      10,
+				Limit:       5,
+			},
+			path: "/search?company=NBC+Universal&country=usa&director=Ken+Kwapis&language=eng&limit=5&network=NBC&offset=10&primaryType=network&query=office&remote_id=tt0386676&type=series&year=2005",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockClient)
+			expected := []models.SearchResult{{ObjectID: "series-73244", Name: "The Office", Year: "2005"}}
+
+			mockClient.On("GetContext", tt.path, mock.Anything).
+				Run(func(args mock.Arguments) {
+					args.Get(1).(*models.Response[[]models.SearchResult]).Data = expected
+				}).
+				Return(nil)
+
+			results, err := SearchWithOptions(mockClient, tt.query)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, results.Results)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResultsNext(t *testing.T) {
+	q := Query{Query: "office", Limit: 2}
+	twoResults := []models.SearchResult{{ObjectID: "1"}, {ObjectID: "2"}}
+
+	tests := []struct {
+		name       string
+		results    Results
+		wantOffset int
+		wantOK     bool
+	}{
+		{"more items", Results{Results: twoResults, Links: models.Links{TotalItems: 5}, query: q}, 2, true},
+		{"last page", Results{Results: twoResults, Links: models.Links{TotalItems: 2}, query: q}, 0, false},
+		{"next link without total", Results{Results: twoResults, Links: models.Links{Next: "https://api4.thetvdb.com/v4/search?query=office&offset=2"}, query: q}, 2, true},
+		{"no results", Results{Links: models.Links{TotalItems: 5}, query: q}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, ok := tt.results.Next()
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantOffset, next.Offset)
+			if ok {
+				assert.Equal(t, "office", next.Query)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package sync
+
+import (
+	"context"
+	gosync "sync"
+	"time"
+
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// Kind identifies the type of record kept in a Store.
+type Kind string
+
+// Record kinds maintained by a Syncer
+const (
+	KindSeries  Kind = "series"
+	KindSeason  Kind = "season"
+	KindEpisode Kind = "episode"
+	KindMovie   Kind = "movie"
+)
+
+// Store persists the records of a local mirror and the time of the last
+// successful sync. Records are *models.SeriesExtended, *models.SeasonExtended,
+// *models.EpisodeExtended or *models.MovieExtended depending on their kind.
+type Store interface {
+	// LastSync returns the time of the last successful sync, or the zero
+	// time if there was none.
+	LastSync(ctx context.Context) (time.Time, error)
+	// SetLastSync records the time of a successful sync.
+	SetLastSync(ctx context.Context, t time.Time) error
+	// Put adds or replaces a record.
+	Put(ctx context.Context, kind Kind, id int, record any) error
+	// Delete removes a record. Deleting a missing record is not an error.
+	Delete(ctx context.Context, kind Kind, id int) error
+	// Redirect removes the record from and makes lookups of it resolve to
+	// the record to, after TVDB merged the two.
+	Redirect(ctx context.Context, kind Kind, from, to int) error
+}
+
+type recordKey struct {
+	kind Kind
+	id   int
+}
+
+// MemoryStore is a Store that keeps records in memory. It is safe for
+// concurrent use.
+type MemoryStore struct {
+	mu        gosync.RWMutex
+	lastSync  time.Time
+	records   map[recordKey]any
+	redirects map[recordKey]int
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		records:   make(map[recordKey]any),
+		redirects: make(map[recordKey]int),
+	}
+}
+
+// LastSync implements Store.
+func (s *MemoryStore) LastSync(ctx context.Context) (time.Time, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastSync, nil
+}
+
+// SetLastSync implements Store.
+func (s *MemoryStore) SetLastSync(ctx context.Context, t time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastSync = t
+	return nil
+}
+
+// Put implements Store.
+func (s *MemoryStore) Put(ctx context.Context, kind Kind, id int, record any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[recordKey{kind, id}] = record
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(ctx context.Context, kind Kind, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, recordKey{kind, id})
+	return nil
+}
+
+// Redirect implements Store.
+func (s *MemoryStore) Redirect(ctx context.Context, kind Kind, from, to int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, recordKey{kind, from})
+	s.redirects[recordKey{kind, from}] = to
+	return nil
+}
+
+// Resolve returns the ID a record was merged into, following chains of
+// merges, or id itself if it was never merged.
+func (s *MemoryStore) Resolve(kind Kind, id int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.resolveLocked(kind, id)
+}
+
+func (s *MemoryStore) resolveLocked(kind Kind, id int) int {
+	// Bounded to guard against redirect cycles
+	for i := 0; i < len(s.redirects); i++ {
+		to, ok := s.redirects[recordKey{kind, id}]
+		if !ok {
+			break
+		}
+		id = to
+	}
+	return id
+}
+
+func (s *MemoryStore) get(kind Kind, id int) any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.records[recordKey{kind, s.resolveLocked(kind, id)}]
+}
+
+// Series returns the stored series with the given or a merged ID.
+func (s *MemoryStore) Series(id int) (*models.SeriesExtended, bool) {
+	series, ok := s.get(KindSeries, id).(*models.SeriesExtended)
+	return series, ok
+}
+
+// Season returns the stored season with the given or a merged ID.
+func (s *MemoryStore) Season(id int) (*models.SeasonExtended, bool) {
+	season, ok := s.get(KindSeason, id).(*models.SeasonExtended)
+	return season, ok
+}
+
+// Episode returns the stored episode with the given or a merged ID.
+func (s *MemoryStore) Episode(id int) (*models.EpisodeExtended, bool) {
+	episode, ok := s.get(KindEpisode, id).(*models.EpisodeExtended)
+	return episode, ok
+}
+
+// Movie returns the stored movie with the given or a merged ID.
+func (s *MemoryStore) Movie(id int) (*models.MovieExtended, bool) {
+	movie, ok := s.get(KindMovie, id).(*models.MovieExtended)
+	return movie, ok
+}
@@ -0,0 +1,250 @@
+// Package sync keeps a local mirror of TVDB series, seasons, episodes and
+// movies up to date using the updates feed.
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/LaughinKuma/tvdb-go-api/client"
+	"github.com/LaughinKuma/tvdb-go-api/endpoints"
+	"github.com/LaughinKuma/tvdb-go-api/models"
+)
+
+// DefaultLookback is how far back the first sync of an empty store reaches.
+const DefaultLookback = 24 * time.Hour
+
+// Source fetches updates and records from TVDB. *tvdb.TVDB satisfies it.
+type Source interface {
+	GetUpdatesContext(ctx context.Context, since time.Time, opts *endpoints.UpdatesOptions) ([]models.EntityUpdate, error)
+	GetSeriesExtendedContext(ctx context.Context, id int, opts *endpoints.ExtendedOptions) (*models.SeriesExtended, error)
+	GetSeasonExtendedContext(ctx context.Context, id int, opts *endpoints.ExtendedOptions) (*models.SeasonExtended, error)
+	GetEpisodeExtendedContext(ctx context.Context, id int, opts *endpoints.ExtendedOptions) (*models.EpisodeExtended, error)
+	GetMovieExtendedContext(ctx context.Context, id int, opts *endpoints.ExtendedOptions) (*models.MovieExtended, error)
+}
+
+// entityKinds maps the entity types of the updates feed to the record kinds
+// kept in the store, in the order they are requested.
+var entityKinds = []struct {
+	entity models.UpdateEntityType
+	kind   Kind
+}{
+	{models.UpdateEntitySeries, KindSeries},
+	{models.UpdateEntitySeasons, KindSeason},
+	{models.UpdateEntityEpisodes, KindEpisode},
+	{models.UpdateEntityMovies, KindMovie},
+}
+
+// Change describes a change applied to the store.
+type Change struct {
+	Kind   Kind
+	ID     int
+	Method models.UpdateMethod
+	// MergedInto is the ID the record was merged into, if it was merged.
+	MergedInto int
+}
+
+// Summary reports the changes applied by a sync.
+type Summary struct {
+	// Since and Until delimit the period covered by the sync.
+	Since time.Time
+	Until time.Time
+
+	Created int
+	Updated int
+	Deleted int
+	Merged  int
+
+	// Changes lists the applied changes in the order they were applied.
+	Changes []Change
+}
+
+// Option configures a Syncer created by New.
+type Option func(*Syncer)
+
+// WithLookback sets how far back the first sync of an empty store reaches.
+func WithLookback(lookback time.Duration) Option {
+	return func(s *Syncer) {
+		s.lookback = lookback
+	}
+}
+
+// WithExtendedOptions sets the options used to fetch extended records, e.g.
+// to include translations.
+func WithExtendedOptions(opts *endpoints.ExtendedOptions) Option {
+	return func(s *Syncer) {
+		s.extended = opts
+	}
+}
+
+// Syncer replays the updates feed into a Store.
+type Syncer struct {
+	source   Source
+	store    Store
+	lookback time.Duration
+	extended *endpoints.ExtendedOptions
+	now      func() time.Time
+}
+
+// New creates a Syncer that reads from source and writes to store.
+func New(source Source, store Store, opts ...Option) *Syncer {
+	s := &Syncer{
+		source:   source,
+		store:    store,
+		lookback: DefaultLookback,
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Run applies every change since the last sync to the store. Creations and
+// updates re-fetch the extended record, deletions remove it and merges
+// redirect the old ID to the record it was merged into. The last sync time
+// only advances when every change was applied, so a failed run is retried
+// in full by the next one.
+func (s *Syncer) Run(ctx context.Context) (*Summary, error) {
+	since, err := s.store.LastSync(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load last sync time: %w", err)
+	}
+
+	// Changes made while the sync runs are picked up by the next one
+	until := s.now()
+	if since.IsZero() {
+		since = until.Add(-s.lookback)
+	}
+	summary := &Summary{Since: since, Until: until}
+
+	updates, err := s.fetchUpdates(ctx, since)
+	if err != nil {
+		return summary, err
+	}
+
+	for _, u := range updates {
+		change, err := s.apply(ctx, u.kind, u.EntityUpdate)
+		if err != nil {
+			return summary, fmt.Errorf("failed to sync %s %d: %w", u.kind, u.RecordID, err)
+		}
+		summary.add(change)
+	}
+
+	if err := s.store.SetLastSync(ctx, until); err != nil {
+		return summary, fmt.Errorf("failed to save last sync time: %w", err)
+	}
+	return summary, nil
+}
+
+type kindUpdate struct {
+	models.EntityUpdate
+	kind Kind
+}
+
+// fetchUpdates returns the latest update of every record changed since the
+// given time, oldest first.
+func (s *Syncer) fetchUpdates(ctx context.Context, since time.Time) ([]kindUpdate, error) {
+	latest := make(map[recordKey]kindUpdate)
+	for _, ek := range entityKinds {
+		updates, err := s.source.GetUpdatesContext(ctx, since, &endpoints.UpdatesOptions{Type: ek.entity})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s updates: %w", ek.entity, err)
+		}
+		for _, u := range updates {
+			key := recordKey{ek.kind, u.RecordID}
+			if prev, ok := latest[key]; !ok || u.TimeStamp >= prev.TimeStamp {
+				latest[key] = kindUpdate{EntityUpdate: u, kind: ek.kind}
+			}
+		}
+	}
+
+	updates := make([]kindUpdate, 0, len(latest))
+	for _, u := range latest {
+		updates = append(updates, u)
+	}
+	sort.Slice(updates, func(i, j int) bool {
+		a, b := updates[i], updates[j]
+		if a.TimeStamp != b.TimeStamp {
+			return a.TimeStamp < b.TimeStamp
+		}
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		return a.RecordID < b.RecordID
+	})
+	return updates, nil
+}
+
+// apply applies one update to the store.
+func (s *Syncer) apply(ctx context.Context, kind Kind, u models.EntityUpdate) (Change, error) {
+	change := Change{Kind: kind, ID: u.RecordID, Method: u.Method}
+
+	if u.MergeToID != 0 {
+		change.MergedInto = u.MergeToID
+		if err := s.store.Redirect(ctx, kind, u.RecordID, u.MergeToID); err != nil {
+			return change, err
+		}
+		// The surviving record changed as well
+		err := s.refresh(ctx, kind, u.MergeToID)
+		if errors.Is(err, client.ErrNotFound) {
+			// Deleted or merged again since; its own update follows
+			return change, s.store.Delete(ctx, kind, u.MergeToID)
+		}
+		return change, err
+	}
+
+	if u.Method == models.UpdateMethodDelete {
+		return change, s.store.Delete(ctx, kind, u.RecordID)
+	}
+
+	err := s.refresh(ctx, kind, u.RecordID)
+	if errors.Is(err, client.ErrNotFound) {
+		// Deleted after the update was recorded
+		change.Method = models.UpdateMethodDelete
+		return change, s.store.Delete(ctx, kind, u.RecordID)
+	}
+	return change, err
+}
+
+// refresh fetches the extended record and stores it.
+func (s *Syncer) refresh(ctx context.Context, kind Kind, id int) error {
+	var (
+		record any
+		err    error
+	)
+	switch kind {
+	case KindSeries:
+		record, err = s.source.GetSeriesExtendedContext(ctx, id, s.extended)
+	case KindSeason:
+		record, err = s.source.GetSeasonExtendedContext(ctx, id, s.extended)
+	case KindEpisode:
+		record, err = s.source.GetEpisodeExtendedContext(ctx, id, s.extended)
+	case KindMovie:
+		record, err = s.source.GetMovieExtendedContext(ctx, id, s.extended)
+	default:
+		return fmt.Errorf("unknown record kind %q", kind)
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.store.Put(ctx, kind, id, record)
+}
+
+func (sum *Summary) add(change Change) {
+	switch {
+	case change.MergedInto != 0:
+		sum.Merged++
+	case change.Method == models.UpdateMethodCreate:
+		sum.Created++
+	case change.Method == models.UpdateMethodDelete:
+		sum.Deleted++
+	default:
+		sum.Updated++
+	}
+	sum.Changes = append(sum.Changes, change)
+}
@@ -0,0 +1,152 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	tvdb "github.com/LaughinKuma/tvdb-go-api"
+	"github.com/LaughinKuma/tvdb-go-api/client"
+	"github.com/LaughinKuma/tvdb-go-api/endpoints"
+	"github.com/LaughinKuma/tvdb-go-api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ Source = (*tvdb.TVDB)(nil)
+
+// fakeSource serves updates and records from memory.
+type fakeSource struct {
+	updates map[models.UpdateEntityType][]models.EntityUpdate
+	since   []time.Time
+	// missing lists series IDs that return a not found error
+	missing map[int]bool
+	err     error
+}
+
+func (f *fakeSource) GetUpdatesContext(ctx context.Context, since time.Time, opts *endpoints.UpdatesOptions) ([]models.EntityUpdate, error) {
+	f.since = append(f.since, since)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.updates[opts.Type], nil
+}
+
+func (f *fakeSource) GetSeriesExtendedContext(ctx context.Context, id int, opts *endpoints.ExtendedOptions) (*models.SeriesExtended, error) {
+	if f.missing[id] {
+		return nil, &client.APIError{StatusCode: http.StatusNotFound}
+	}
+	return &models.SeriesExtended{Series: models.Series{ID: id}}, nil
+}
+
+func (f *fakeSource) GetSeasonExtendedContext(ctx context.Context, id int, opts *endpoints.ExtendedOptions) (*models.SeasonExtended, error) {
+	return &models.SeasonExtended{Season: models.Season{ID: id}}, nil
+}
+
+func (f *fakeSource) GetEpisodeExtendedContext(ctx context.Context, id int, opts *endpoints.ExtendedOptions) (*models.EpisodeExtended, error) {
+	return &models.EpisodeExtended{Episode: models.Episode{ID: id}}, nil
+}
+
+func (f *fakeSource) GetMovieExtendedContext(ctx context.Context, id int, opts *endpoints.ExtendedOptions) (*models.MovieExtended, error) {
+	return &models.MovieExtended{Movie: models.Movie{ID: id}}, nil
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+
+	store := NewMemoryStore()
+	require.NoError(t, store.Put(ctx, KindSeries, 10, &models.SeriesExtended{Series: models.Series{ID: 10}}))
+	require.NoError(t, store.Put(ctx, KindSeries, 11, &models.SeriesExtended{Series: models.Series{ID: 11}}))
+	require.NoError(t, store.Put(ctx, KindSeries, 15, &models.SeriesExtended{Series: models.Series{ID: 15}}))
+	require.NoError(t, store.Put(ctx, KindMovie, 30, &models.MovieExtended{Movie: models.Movie{ID: 30}}))
+
+	source := &fakeSource{
+		missing: map[int]bool{13: true, 15: true},
+		updates: map[models.UpdateEntityType][]models.EntityUpdate{
+			models.UpdateEntitySeries: {
+				{RecordID: 12, Method: models.UpdateMethodCreate, TimeStamp: 1},
+				{RecordID: 12, Method: models.UpdateMethodUpdate, TimeStamp: 2},
+				{RecordID: 10, Method: models.UpdateMethodDelete, TimeStamp: 3, MergeToID: 11},
+				{RecordID: 13, Method: models.UpdateMethodUpdate, TimeStamp: 4},
+				// Merged into a series that no longer exists
+				{RecordID: 14, Method: models.UpdateMethodDelete, TimeStamp: 4, MergeToID: 15},
+			},
+			models.UpdateEntityEpisodes: {
+				{RecordID: 20, Method: models.UpdateMethodCreate, TimeStamp: 5},
+			},
+			models.UpdateEntityMovies: {
+				{RecordID: 30, Method: models.UpdateMethodDelete, TimeStamp: 6},
+			},
+		},
+	}
+
+	syncer := New(source, store, WithLookback(time.Hour))
+	syncer.now = func() time.Time { return now }
+
+	summary, err := syncer.Run(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-time.Hour), summary.Since)
+	assert.Equal(t, now, summary.Until)
+	assert.Equal(t, 1, summary.Created)
+	assert.Equal(t, 1, summary.Updated)
+	assert.Equal(t, 2, summary.Deleted)
+	assert.Equal(t, 2, summary.Merged)
+	assert.Equal(t, []Change{
+		{Kind: KindSeries, ID: 12, Method: models.UpdateMethodUpdate},
+		{Kind: KindSeries, ID: 10, Method: models.UpdateMethodDelete, MergedInto: 11},
+		{Kind: KindSeries, ID: 13, Method: models.UpdateMethodDelete},
+		{Kind: KindSeries, ID: 14, Method: models.UpdateMethodDelete, MergedInto: 15},
+		{Kind: KindEpisode, ID: 20, Method: models.UpdateMethodCreate},
+		{Kind: KindMovie, ID: 30, Method: models.UpdateMethodDelete},
+	}, summary.Changes)
+
+	// Merged IDs resolve to the surviving record
+	series, ok := store.Series(10)
+	require.True(t, ok)
+	assert.Equal(t, 11, series.ID)
+
+	_, ok = store.Series(12)
+	assert.True(t, ok)
+	_, ok = store.Series(13)
+	assert.False(t, ok)
+	_, ok = store.Series(14)
+	assert.False(t, ok)
+	_, ok = store.Series(15)
+	assert.False(t, ok)
+	assert.Equal(t, 15, store.Resolve(KindSeries, 14))
+	_, ok = store.Episode(20)
+	assert.True(t, ok)
+	_, ok = store.Movie(30)
+	assert.False(t, ok)
+
+	lastSync, err := store.LastSync(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, now, lastSync)
+
+	// The next run starts where this one ended
+	syncer.now = func() time.Time { return now.Add(time.Hour) }
+	_, err = syncer.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, now, source.since[len(source.since)-1])
+}
+
+func TestRunErrorKeepsLastSync(t *testing.T) {
+	ctx := context.Background()
+	lastSync := time.Unix(1700000000, 0)
+
+	store := NewMemoryStore()
+	require.NoError(t, store.SetLastSync(ctx, lastSync))
+
+	source := &fakeSource{err: errors.New("boom")}
+	syncer := New(source, store)
+
+	_, err := syncer.Run(ctx)
+
+	assert.Error(t, err)
+	got, _ := store.LastSync(ctx)
+	assert.Equal(t, lastSync, got)
+}
@@ -1,6 +1,10 @@
 package tvdb
 
 import (
+	"context"
+	"time"
+
+	"github.com/LaughinKuma/tvdb-go-api/artwork"
 	"github.com/LaughinKuma/tvdb-go-api/client"
 	"github.com/LaughinKuma/tvdb-go-api/endpoints"
 	"github.com/LaughinKuma/tvdb-go-api/models"
@@ -11,8 +15,10 @@ type TVDB struct {
 	Client *client.Client
 }
 
-func New(apiKey string) (*TVDB, error) {
-	c, err := client.NewClient(apiKey)
+// New creates a TVDB instance and logs in with the given API key. Options
+// are passed through to client.NewClient.
+func New(apiKey string, opts ...client.Option) (*TVDB, error) {
+	c, err := client.NewClient(apiKey, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -23,27 +29,364 @@ func (t *TVDB) Search(query string) ([]models.SearchResult, error) {
 	return search.Search(t.Client, query)
 }
 
+// SearchContext wraps the search.SearchContext function
+func (t *TVDB) SearchContext(ctx context.Context, query string) ([]models.SearchResult, error) {
+	return search.SearchContext(ctx, t.Client, query)
+}
+
+// SearchWithOptions wraps the search.SearchWithOptions function
+func (t *TVDB) SearchWithOptions(q search.Query) (*search.Results, error) {
+	return search.SearchWithOptions(t.Client, q)
+}
+
+// SearchWithOptionsContext wraps the search.SearchWithOptionsContext function
+func (t *TVDB) SearchWithOptionsContext(ctx context.Context, q search.Query) (*search.Results, error) {
+	return search.SearchWithOptionsContext(ctx, t.Client, q)
+}
+
+// SearchByRemoteID wraps the search.ByRemoteID function
+func (t *TVDB) SearchByRemoteID(remoteID string) ([]models.RemoteIDResult, error) {
+	return search.ByRemoteID(t.Client, remoteID)
+}
+
+// SearchByRemoteIDContext wraps the search.ByRemoteIDContext function
+func (t *TVDB) SearchByRemoteIDContext(ctx context.Context, remoteID string) ([]models.RemoteIDResult, error) {
+	return search.ByRemoteIDContext(ctx, t.Client, remoteID)
+}
+
 // GetSeriesByID wraps the endpoints.GetSeriesByID function
 func (t *TVDB) GetSeriesByID(id int) (*models.Series, error) {
 	return endpoints.GetSeriesByID(t.Client, id)
 }
 
+// GetSeriesByIDContext wraps the endpoints.GetSeriesByIDContext function
+func (t *TVDB) GetSeriesByIDContext(ctx context.Context, id int) (*models.Series, error) {
+	return endpoints.GetSeriesByIDContext(ctx, t.Client, id)
+}
+
+// GetSeriesBySlug wraps the endpoints.GetSeriesBySlug function
+func (t *TVDB) GetSeriesBySlug(slug string) (*models.Series, error) {
+	return endpoints.GetSeriesBySlug(t.Client, slug)
+}
+
+// GetSeriesBySlugContext wraps the endpoints.GetSeriesBySlugContext function
+func (t *TVDB) GetSeriesBySlugContext(ctx context.Context, slug string) (*models.Series, error) {
+	return endpoints.GetSeriesBySlugContext(ctx, t.Client, slug)
+}
+
+// GetSeriesExtended wraps the endpoints.GetSeriesExtended function
+func (t *TVDB) GetSeriesExtended(id int, opts *endpoints.ExtendedOptions) (*models.SeriesExtended, error) {
+	return endpoints.GetSeriesExtended(t.Client, id, opts)
+}
+
+// GetSeriesExtendedContext wraps the endpoints.GetSeriesExtendedContext function
+func (t *TVDB) GetSeriesExtendedContext(ctx context.Context, id int, opts *endpoints.ExtendedOptions) (*models.SeriesExtended, error) {
+	return endpoints.GetSeriesExtendedContext(ctx, t.Client, id, opts)
+}
+
 // GetSeriesEpisodes wraps the endpoints.GetSeriesEpisodes function
 func (t *TVDB) GetSeriesEpisodes(seriesID int, seasonType string, page int) ([]models.Episode, int, int, error) {
 	return endpoints.GetSeriesEpisodes(t.Client, seriesID, seasonType, page)
 }
 
+// GetSeriesEpisodesContext wraps the endpoints.GetSeriesEpisodesContext function
+func (t *TVDB) GetSeriesEpisodesContext(ctx context.Context, seriesID int, seasonType string, page int) ([]models.Episode, int, int, error) {
+	return endpoints.GetSeriesEpisodesContext(ctx, t.Client, seriesID, seasonType, page)
+}
+
+// NewSeriesEpisodesIterator wraps the endpoints.NewSeriesEpisodesIterator function
+func (t *TVDB) NewSeriesEpisodesIterator(ctx context.Context, seriesID int, seasonType string) *endpoints.SeriesEpisodesIterator {
+	return endpoints.NewSeriesEpisodesIterator(ctx, t.Client, seriesID, seasonType)
+}
+
+// GetAllSeriesEpisodes wraps the endpoints.GetAllSeriesEpisodes function
+func (t *TVDB) GetAllSeriesEpisodes(seriesID int, seasonType string, concurrency int) ([]models.Episode, error) {
+	return endpoints.GetAllSeriesEpisodes(t.Client, seriesID, seasonType, concurrency)
+}
+
+// GetAllSeriesEpisodesContext wraps the endpoints.GetAllSeriesEpisodesContext function
+func (t *TVDB) GetAllSeriesEpisodesContext(ctx context.Context, seriesID int, seasonType string, concurrency int) ([]models.Episode, error) {
+	return endpoints.GetAllSeriesEpisodesContext(ctx, t.Client, seriesID, seasonType, concurrency)
+}
+
 // GetEpisodeByID wraps the endpoints.GetEpisodeByID function
 func (t *TVDB) GetEpisodeByID(id int) (*models.Episode, error) {
 	return endpoints.GetEpisodeByID(t.Client, id)
 }
 
+// GetEpisodeByIDContext wraps the endpoints.GetEpisodeByIDContext function
+func (t *TVDB) GetEpisodeByIDContext(ctx context.Context, id int) (*models.Episode, error) {
+	return endpoints.GetEpisodeByIDContext(ctx, t.Client, id)
+}
+
+// GetEpisodeExtended wraps the endpoints.GetEpisodeExtended function
+func (t *TVDB) GetEpisodeExtended(id int, opts *endpoints.ExtendedOptions) (*models.EpisodeExtended, error) {
+	return endpoints.GetEpisodeExtended(t.Client, id, opts)
+}
+
+// GetEpisodeExtendedContext wraps the endpoints.GetEpisodeExtendedContext function
+func (t *TVDB) GetEpisodeExtendedContext(ctx context.Context, id int, opts *endpoints.ExtendedOptions) (*models.EpisodeExtended, error) {
+	return endpoints.GetEpisodeExtendedContext(ctx, t.Client, id, opts)
+}
+
 // GetSeriesSeasons wraps the endpoints.GetSeriesSeasons function
 func (t *TVDB) GetSeriesSeasons(seriesID int) ([]models.Season, error) {
 	return endpoints.GetSeriesSeasons(t.Client, seriesID)
 }
 
+// GetSeriesSeasonsContext wraps the endpoints.GetSeriesSeasonsContext function
+func (t *TVDB) GetSeriesSeasonsContext(ctx context.Context, seriesID int) ([]models.Season, error) {
+	return endpoints.GetSeriesSeasonsContext(ctx, t.Client, seriesID)
+}
+
+// GetSeasonByID wraps the endpoints.GetSeasonByID function
+func (t *TVDB) GetSeasonByID(id int) (*models.Season, error) {
+	return endpoints.GetSeasonByID(t.Client, id)
+}
+
+// GetSeasonByIDContext wraps the endpoints.GetSeasonByIDContext function
+func (t *TVDB) GetSeasonByIDContext(ctx context.Context, id int) (*models.Season, error) {
+	return endpoints.GetSeasonByIDContext(ctx, t.Client, id)
+}
+
+// GetSeasonExtended wraps the endpoints.GetSeasonExtended function
+func (t *TVDB) GetSeasonExtended(id int, opts *endpoints.ExtendedOptions) (*models.SeasonExtended, error) {
+	return endpoints.GetSeasonExtended(t.Client, id, opts)
+}
+
+// GetSeasonExtendedContext wraps the endpoints.GetSeasonExtendedContext function
+func (t *TVDB) GetSeasonExtendedContext(ctx context.Context, id int, opts *endpoints.ExtendedOptions) (*models.SeasonExtended, error) {
+	return endpoints.GetSeasonExtendedContext(ctx, t.Client, id, opts)
+}
+
+// GetSeasonTranslation wraps the endpoints.GetSeasonTranslation function
+func (t *TVDB) GetSeasonTranslation(id int, language string) (*models.Translation, error) {
+	return endpoints.GetSeasonTranslation(t.Client, id, language)
+}
+
+// GetSeasonTranslationContext wraps the endpoints.GetSeasonTranslationContext function
+func (t *TVDB) GetSeasonTranslationContext(ctx context.Context, id int, language string) (*models.Translation, error) {
+	return endpoints.GetSeasonTranslationContext(ctx, t.Client, id, language)
+}
+
+// GetSeasonTypes wraps the endpoints.GetSeasonTypes function
+func (t *TVDB) GetSeasonTypes() ([]models.SeasonType, error) {
+	return endpoints.GetSeasonTypes(t.Client)
+}
+
+// GetSeasonTypesContext wraps the endpoints.GetSeasonTypesContext function
+func (t *TVDB) GetSeasonTypesContext(ctx context.Context) ([]models.SeasonType, error) {
+	return endpoints.GetSeasonTypesContext(ctx, t.Client)
+}
+
 // GetMovieByID wraps the endpoints.GetMovieByID function
 func (t *TVDB) GetMovieByID(id int) (*models.Movie, error) {
 	return endpoints.GetMovieByID(t.Client, id)
-}
\ No newline at end of file
+}
+
+// GetMovieByIDContext wraps the endpoints.GetMovieByIDContext function
+func (t *TVDB) GetMovieByIDContext(ctx context.Context, id int) (*models.Movie, error) {
+	return endpoints.GetMovieByIDContext(ctx, t.Client, id)
+}
+
+// GetMovieBySlug wraps the endpoints.GetMovieBySlug function
+func (t *TVDB) GetMovieBySlug(slug string) (*models.Movie, error) {
+	return endpoints.GetMovieBySlug(t.Client, slug)
+}
+
+// GetMovieBySlugContext wraps the endpoints.GetMovieBySlugContext function
+func (t *TVDB) GetMovieBySlugContext(ctx context.Context, slug string) (*models.Movie, error) {
+	return endpoints.GetMovieBySlugContext(ctx, t.Client, slug)
+}
+
+// GetMovieExtended wraps the endpoints.GetMovieExtended function
+func (t *TVDB) GetMovieExtended(id int, opts *endpoints.ExtendedOptions) (*models.MovieExtended, error) {
+	return endpoints.GetMovieExtended(t.Client, id, opts)
+}
+
+// GetMovieExtendedContext wraps the endpoints.GetMovieExtendedContext function
+func (t *TVDB) GetMovieExtendedContext(ctx context.Context, id int, opts *endpoints.ExtendedOptions) (*models.MovieExtended, error) {
+	return endpoints.GetMovieExtendedContext(ctx, t.Client, id, opts)
+}
+
+// GetPersonByID wraps the endpoints.GetPersonByID function
+func (t *TVDB) GetPersonByID(id int) (*models.Person, error) {
+	return endpoints.GetPersonByID(t.Client, id)
+}
+
+// GetPersonByIDContext wraps the endpoints.GetPersonByIDContext function
+func (t *TVDB) GetPersonByIDContext(ctx context.Context, id int) (*models.Person, error) {
+	return endpoints.GetPersonByIDContext(ctx, t.Client, id)
+}
+
+// GetPersonExtended wraps the endpoints.GetPersonExtended function
+func (t *TVDB) GetPersonExtended(id int, opts *endpoints.ExtendedOptions) (*models.PersonExtended, error) {
+	return endpoints.GetPersonExtended(t.Client, id, opts)
+}
+
+// GetPersonExtendedContext wraps the endpoints.GetPersonExtendedContext function
+func (t *TVDB) GetPersonExtendedContext(ctx context.Context, id int, opts *endpoints.ExtendedOptions) (*models.PersonExtended, error) {
+	return endpoints.GetPersonExtendedContext(ctx, t.Client, id, opts)
+}
+
+// GetPersonTranslation wraps the endpoints.GetPersonTranslation function
+func (t *TVDB) GetPersonTranslation(id int, language string) (*models.Translation, error) {
+	return endpoints.GetPersonTranslation(t.Client, id, language)
+}
+
+// GetPersonTranslationContext wraps the endpoints.GetPersonTranslationContext function
+func (t *TVDB) GetPersonTranslationContext(ctx context.Context, id int, language string) (*models.Translation, error) {
+	return endpoints.GetPersonTranslationContext(ctx, t.Client, id, language)
+}
+
+// GetPeopleTypes wraps the endpoints.GetPeopleTypes function
+func (t *TVDB) GetPeopleTypes() ([]models.PeopleType, error) {
+	return endpoints.GetPeopleTypes(t.Client)
+}
+
+// GetPeopleTypesContext wraps the endpoints.GetPeopleTypesContext function
+func (t *TVDB) GetPeopleTypesContext(ctx context.Context) ([]models.PeopleType, error) {
+	return endpoints.GetPeopleTypesContext(ctx, t.Client)
+}
+
+// GetCharacterByID wraps the endpoints.GetCharacterByID function
+func (t *TVDB) GetCharacterByID(id int) (*models.Character, error) {
+	return endpoints.GetCharacterByID(t.Client, id)
+}
+
+// GetCharacterByIDContext wraps the endpoints.GetCharacterByIDContext function
+func (t *TVDB) GetCharacterByIDContext(ctx context.Context, id int) (*models.Character, error) {
+	return endpoints.GetCharacterByIDContext(ctx, t.Client, id)
+}
+
+// GetArtworkByID wraps the endpoints.GetArtworkByID function
+func (t *TVDB) GetArtworkByID(id int) (*models.Artwork, error) {
+	return endpoints.GetArtworkByID(t.Client, id)
+}
+
+// GetArtworkByIDContext wraps the endpoints.GetArtworkByIDContext function
+func (t *TVDB) GetArtworkByIDContext(ctx context.Context, id int) (*models.Artwork, error) {
+	return endpoints.GetArtworkByIDContext(ctx, t.Client, id)
+}
+
+// GetArtworkExtended wraps the endpoints.GetArtworkExtended function
+func (t *TVDB) GetArtworkExtended(id int) (*models.ArtworkExtended, error) {
+	return endpoints.GetArtworkExtended(t.Client, id)
+}
+
+// GetArtworkExtendedContext wraps the endpoints.GetArtworkExtendedContext function
+func (t *TVDB) GetArtworkExtendedContext(ctx context.Context, id int) (*models.ArtworkExtended, error) {
+	return endpoints.GetArtworkExtendedContext(ctx, t.Client, id)
+}
+
+// GetSeriesArtworks wraps the endpoints.GetSeriesArtworks function
+func (t *TVDB) GetSeriesArtworks(seriesID int, opts *endpoints.ArtworkOptions) ([]models.Artwork, error) {
+	return endpoints.GetSeriesArtworks(t.Client, seriesID, opts)
+}
+
+// GetSeriesArtworksContext wraps the endpoints.GetSeriesArtworksContext function
+func (t *TVDB) GetSeriesArtworksContext(ctx context.Context, seriesID int, opts *endpoints.ArtworkOptions) ([]models.Artwork, error) {
+	return endpoints.GetSeriesArtworksContext(ctx, t.Client, seriesID, opts)
+}
+
+// GetArtworkTypes wraps the endpoints.GetArtworkTypes function
+func (t *TVDB) GetArtworkTypes() ([]models.ArtworkType, error) {
+	return endpoints.GetArtworkTypes(t.Client)
+}
+
+// GetArtworkTypesContext wraps the endpoints.GetArtworkTypesContext function
+func (t *TVDB) GetArtworkTypesContext(ctx context.Context) ([]models.ArtworkType, error) {
+	return endpoints.GetArtworkTypesContext(ctx, t.Client)
+}
+
+// GetArtworkStatuses wraps the endpoints.GetArtworkStatuses function
+func (t *TVDB) GetArtworkStatuses() ([]models.ArtworkStatus, error) {
+	return endpoints.GetArtworkStatuses(t.Client)
+}
+
+// GetArtworkStatusesContext wraps the endpoints.GetArtworkStatusesContext function
+func (t *TVDB) GetArtworkStatusesContext(ctx context.Context) ([]models.ArtworkStatus, error) {
+	return endpoints.GetArtworkStatusesContext(ctx, t.Client)
+}
+
+// NewArtworkDownloader creates an artwork.Downloader writing under dir that
+// downloads through the client's transport and retry policy
+func (t *TVDB) NewArtworkDownloader(dir string, opts ...artwork.Option) *artwork.Downloader {
+	opts = append([]artwork.Option{artwork.WithHTTPClient(t.Client.HTTPClient())}, opts...)
+	return artwork.NewDownloader(dir, opts...)
+}
+
+// NewUpdatesIterator wraps the endpoints.NewUpdatesIterator function
+func (t *TVDB) NewUpdatesIterator(ctx context.Context, since time.Time, opts *endpoints.UpdatesOptions) *endpoints.UpdatesIterator {
+	return endpoints.NewUpdatesIterator(ctx, t.Client, since, opts)
+}
+
+// GetUpdates wraps the endpoints.GetUpdates function
+func (t *TVDB) GetUpdates(since time.Time, opts *endpoints.UpdatesOptions) ([]models.EntityUpdate, error) {
+	return endpoints.GetUpdates(t.Client, since, opts)
+}
+
+// GetUpdatesContext wraps the endpoints.GetUpdatesContext function
+func (t *TVDB) GetUpdatesContext(ctx context.Context, since time.Time, opts *endpoints.UpdatesOptions) ([]models.EntityUpdate, error) {
+	return endpoints.GetUpdatesContext(ctx, t.Client, since, opts)
+}
+
+// GetTranslation wraps the endpoints.GetTranslation function
+func (t *TVDB) GetTranslation(entity endpoints.TranslationEntity, id int, language string) (*models.Translation, error) {
+	return endpoints.GetTranslation(t.Client, entity, id, language)
+}
+
+// GetTranslationContext wraps the endpoints.GetTranslationContext function
+func (t *TVDB) GetTranslationContext(ctx context.Context, entity endpoints.TranslationEntity, id int, language string) (*models.Translation, error) {
+	return endpoints.GetTranslationContext(ctx, t.Client, entity, id, language)
+}
+
+// GetTranslationWithFallback wraps the endpoints.GetTranslationWithFallback function
+func (t *TVDB) GetTranslationWithFallback(entity endpoints.TranslationEntity, id int, languages []string) (*models.Translation, error) {
+	return endpoints.GetTranslationWithFallback(t.Client, entity, id, languages)
+}
+
+// GetTranslationWithFallbackContext wraps the endpoints.GetTranslationWithFallbackContext function
+func (t *TVDB) GetTranslationWithFallbackContext(ctx context.Context, entity endpoints.TranslationEntity, id int, languages []string) (*models.Translation, error) {
+	return endpoints.GetTranslationWithFallbackContext(ctx, t.Client, entity, id, languages)
+}
+
+// GetSeriesTranslation wraps the endpoints.GetSeriesTranslation function
+func (t *TVDB) GetSeriesTranslation(id int, language string) (*models.Translation, error) {
+	return endpoints.GetSeriesTranslation(t.Client, id, language)
+}
+
+// GetSeriesTranslationContext wraps the endpoints.GetSeriesTranslationContext function
+func (t *TVDB) GetSeriesTranslationContext(ctx context.Context, id int, language string) (*models.Translation, error) {
+	return endpoints.GetSeriesTranslationContext(ctx, t.Client, id, language)
+}
+
+// GetEpisodeTranslation wraps the endpoints.GetEpisodeTranslation function
+func (t *TVDB) GetEpisodeTranslation(id int, language string) (*models.Translation, error) {
+	return endpoints.GetEpisodeTranslation(t.Client, id, language)
+}
+
+// GetEpisodeTranslationContext wraps the endpoints.GetEpisodeTranslationContext function
+func (t *TVDB) GetEpisodeTranslationContext(ctx context.Context, id int, language string) (*models.Translation, error) {
+	return endpoints.GetEpisodeTranslationContext(ctx, t.Client, id, language)
+}
+
+// GetMovieTranslation wraps the endpoints.GetMovieTranslation function
+func (t *TVDB) GetMovieTranslation(id int, language string) (*models.Translation, error) {
+	return endpoints.GetMovieTranslation(t.Client, id, language)
+}
+
+// GetMovieTranslationContext wraps the endpoints.GetMovieTranslationContext function
+func (t *TVDB) GetMovieTranslationContext(ctx context.Context, id int, language string) (*models.Translation, error) {
+	return endpoints.GetMovieTranslationContext(ctx, t.Client, id, language)
+}
+
+// GetSourceTypes wraps the endpoints.GetSourceTypes function
+func (t *TVDB) GetSourceTypes() ([]models.SourceType, error) {
+	return endpoints.GetSourceTypes(t.Client)
+}
+
+// GetSourceTypesContext wraps the endpoints.GetSourceTypesContext function
+func (t *TVDB) GetSourceTypesContext(ctx context.Context) ([]models.SourceType, error) {
+	return endpoints.GetSourceTypesContext(ctx, t.Client)
+}